@@ -7,6 +7,7 @@ import (
 
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 const (
@@ -19,12 +20,16 @@ const (
 type AccountMsgI interface {
 	BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error)
 	GenerateOrBroadcastTxWithFactory(msgs ...sdk.Msg) error
+	ExecAs(granter sdk.AccAddress, msgs ...sdk.Msg) (*sdk.TxResponse, error)
 	GetFactory() clienttx.Factory
 }
 
 type AccountMsg struct {
 	account AccountI
 	factory clienttx.Factory
+	// granter is set when messages should be executed on behalf of another
+	// account through x/authz. See WithExecAs.
+	granter sdk.AccAddress
 }
 
 var _ AccountMsgI = (*AccountMsg)(nil)
@@ -83,6 +88,51 @@ func (a *AccountMsg) GetAccount() AccountI {
 	return a.account
 }
 
+// GetGranter returns the granter messages are executed on behalf of, if any
+func (a *AccountMsg) GetGranter() sdk.AccAddress {
+	return a.granter
+}
+
+// BuildExecMsg wraps the messages into an authz MsgExec signed by this account (the grantee)
+// The inner messages must use the granter as their signer
+func (a *AccountMsg) BuildExecMsg(msgs ...sdk.Msg) (*authz.MsgExec, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no messages provided to wrap")
+	}
+
+	grantee := a.account.GetCosmosAddress()
+	if grantee.Empty() {
+		return nil, fmt.Errorf("account cosmos address is empty, account name: %s", a.account.GetAccountName())
+	}
+
+	msg := authz.NewMsgExec(grantee, msgs)
+	return &msg, nil
+}
+
+// ExecAs wraps the messages in an authz MsgExec and broadcasts them on behalf of the granter
+// The granter must have granted this account an authorization for every message type
+func (a *AccountMsg) ExecAs(granter sdk.AccAddress, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if granter.Empty() {
+		return nil, errors.New("granter address cannot be empty")
+	}
+
+	return a.WithExecAs(granter).BroadcastTx(msgs...)
+}
+
+// wrapExec wraps the messages in a MsgExec when the AccountMsg is in exec mode
+func (a *AccountMsg) wrapExec(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	if a.granter.Empty() {
+		return msgs, nil
+	}
+
+	execMsg, err := a.BuildExecMsg(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return []sdk.Msg{execMsg}, nil
+}
+
 // GenerateOrBroadcastTxWithFactory generates or broadcasts a transaction using the factory
 func (a *AccountMsg) GenerateOrBroadcastTxWithFactory(msgs ...sdk.Msg) error {
 	if len(msgs) == 0 {
@@ -94,6 +144,11 @@ func (a *AccountMsg) GenerateOrBroadcastTxWithFactory(msgs ...sdk.Msg) error {
 		return fmt.Errorf("account must be of type *Account")
 	}
 
+	msgs, err := a.wrapExec(msgs)
+	if err != nil {
+		return err
+	}
+
 	ctx := account.client.GetClientCTX()
 	return clienttx.GenerateOrBroadcastTxWithFactory(ctx, a.factory, msgs...)
 }
@@ -115,6 +170,12 @@ func (a *AccountMsg) BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
 		return nil, fmt.Errorf("account cosmos address is empty, account name: %s", account.GetAccountName())
 	}
 
+	// Wrap messages in MsgExec when acting on behalf of a granter
+	msgs, err := a.wrapExec(msgs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get client context
 	ctx := account.client.GetClientCTX()
 
@@ -205,6 +266,41 @@ func (a *AccountMsg) BroadcastTxAndWait(msgs ...sdk.Msg) (*sdk.TxResponse, error
 	return res, nil
 }
 
+// EstimateGas simulates a transaction with the provided messages and returns the gas it needs, with the gas adjustment applied
+func (a *AccountMsg) EstimateGas(msgs ...sdk.Msg) (uint64, error) {
+	if len(msgs) == 0 {
+		return 0, errors.New("no messages provided to simulate")
+	}
+
+	account, ok := a.account.(*Account)
+	if !ok {
+		return 0, fmt.Errorf("account must be of type *Account")
+	}
+
+	msgs, err := a.wrapExec(msgs)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := account.client.GetClientCTX()
+	if ctx.Offline {
+		return 0, errors.New("cannot estimate gas in offline mode")
+	}
+
+	txf, err := a.factory.Prepare(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare transaction factory: %w", err)
+	}
+
+	_, adjusted, err := clienttx.CalculateGas(ctx, txf, msgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to calculate gas for transaction (from: %s): %w",
+			account.cosmosAddress.String(), err)
+	}
+
+	return adjusted, nil
+}
+
 // NOTE: THESE ARE UTILITIES METHOD (to modify default tx factory setting)
 // WithGas returns a new AccountMsg with the specified gas limit
 func (a *AccountMsg) WithGas(gas uint64) *AccountMsg {
@@ -241,6 +337,14 @@ func (a *AccountMsg) WithMemo(memo string) *AccountMsg {
 	return &newAccountMsg
 }
 
+// WithExecAs returns a new AccountMsg that wraps every broadcast in an authz MsgExec
+// so the messages are executed on behalf of the granter
+func (a *AccountMsg) WithExecAs(granter sdk.AccAddress) *AccountMsg {
+	newAccountMsg := *a
+	newAccountMsg.granter = granter
+	return &newAccountMsg
+}
+
 // WithTimeoutHeight returns a new AccountMsg with the specified timeout height
 func (a *AccountMsg) WithTimeoutHeight(timeoutHeight uint64) *AccountMsg {
 	newAccountMsg := *a
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	_ "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/client"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/relayer"
+)
+
+// mnemonicEnv holds the relayer key, it is never read from a flag so it stays out of the process list
+const mnemonicEnv = "RELAYER_MNEMONIC"
+
+func main() {
+	listen := flag.String("listen", ":8080", "address the HTTP API listens on")
+	mainnet := flag.Bool("mainnet", false, "connect to mainnet instead of fivenet")
+	rpcURL := flag.String("rpc", "", "custom Tendermint RPC URL, with -api, -evm-rpc and -chain-id")
+	apiURL := flag.String("api", "", "custom REST API URL")
+	evmRPC := flag.String("evm-rpc", "", "custom EVM JSON-RPC URL")
+	chainID := flag.String("chain-id", "", "custom chain ID")
+	contracts := flag.String("contracts", "", "comma separated contracts to relay for, any contract when empty")
+	rateLimit := flag.Int("rate", relayer.DefaultRateLimit, "permits per owner per window")
+	rateWindow := flag.Duration("window", relayer.DefaultRateWindow, "rate limit window")
+	queueSize := flag.Int("queue", relayer.DefaultQueueSize, "permits waiting to be broadcast")
+	flag.Parse()
+
+	mnemonic := os.Getenv(mnemonicEnv)
+	if mnemonic == "" {
+		log.Fatalf("%s is not set", mnemonicEnv)
+	}
+
+	config := relayer.DefaultConfig()
+	config.RateLimit = *rateLimit
+	config.RateWindow = *rateWindow
+	config.QueueSize = *queueSize
+	for _, contract := range strings.Split(*contracts, ",") {
+		contract = strings.TrimSpace(contract)
+		if contract == "" {
+			continue
+		}
+		if !common.IsHexAddress(contract) {
+			log.Fatalf("invalid contract address %q", contract)
+		}
+		config.Contracts = append(config.Contracts, common.HexToAddress(contract))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var (
+		c   *client.Client
+		err error
+	)
+	if *rpcURL != "" {
+		c, err = client.NewCustomClient(ctx, *rpcURL, *apiURL, *evmRPC, *chainID)
+	} else {
+		c, err = client.NewClient(ctx, *mainnet)
+	}
+	if err != nil {
+		log.Fatalf("failed to create client: %v", err)
+	}
+
+	acc, err := account.NewAccount(c, "relayer", mnemonic, "")
+	if err != nil {
+		log.Fatalf("failed to create account: %v", err)
+	}
+
+	r, err := relayer.New(relayer.NewEVMChain(evm.NewEVMClient(*acc)), config)
+	if err != nil {
+		log.Fatalf("failed to create relayer: %v", err)
+	}
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           r.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := r.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("relayer stopped: %v", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("Relaying permits as %s on %s\n", acc.GetEVMAddress().Hex(), *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("server failed: %v", err)
+	}
+}
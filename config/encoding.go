@@ -18,6 +18,7 @@ import (
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 
 	// cosmos modules
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	// SixProtocol modules
@@ -54,6 +55,7 @@ func MakeConfig() sdktestutil.TestEncodingConfig {
 	// Register standard Cosmos modules
 	authtypes.RegisterInterfaces(interfaceRegistry)
 	banktypes.RegisterInterfaces(interfaceRegistry)
+	authz.RegisterInterfaces(interfaceRegistry)
 
 	// Register SixProtocol modules
 	nftmngrmoduletypes.RegisterInterfaces(interfaceRegistry)
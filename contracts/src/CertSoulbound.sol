@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {ERC721} from "openzeppelin-contracts/token/ERC721/ERC721.sol";
+import {ERC721Enumerable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import {ERC721Burnable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Burnable.sol";
+import {Strings} from "openzeppelin-contracts/utils/Strings.sol";
+import {Ownable} from "openzeppelin-contracts/access/Ownable.sol";
+
+error NonExistentTokenURI();
+error TokenLocked(uint256 tokenId);
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
+
+/**
+ * @dev Minimal soulbound NFT interface, see https://eips.ethereum.org/EIPS/eip-5192
+ */
+interface IERC5192 {
+    event Locked(uint256 tokenId);
+    event Unlocked(uint256 tokenId);
+
+    function locked(uint256 tokenId) external view returns (bool);
+}
+
+/**
+ * @dev Certificate contract whose tokens cannot be transferred by their holders.
+ *
+ * Every token is locked when minted. Holders may still burn their token. For a recovery
+ * transfer, such as a holder who lost their key, the issuer (the contract owner) unlocks the
+ * token. The next transfer moves it and locks it again.
+ */
+contract LBBCertSoulbound is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, IERC5192 {
+    using Strings for uint256;
+    string private _baseTokenURI;
+
+    // Tokens the issuer unlocked for one transfer, every other token is locked
+    mapping(uint256 => bool) private _unlocked;
+
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
+    // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event safeMintEvent(address to, uint256 tokenId);
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        string memory baseURI,
+        address initialOwner
+    ) ERC721(name, symbol) Ownable(initialOwner) {
+        _baseTokenURI = baseURI;
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyOwner {
+        _safeMint(to, tokenId);
+        emit Locked(tokenId);
+    }
+
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyOwner {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+            emit Locked(ids[i]);
+        }
+    }
+
+    // BASE URI
+    function _baseURI() internal view virtual override returns (string memory) {
+        return _baseTokenURI;
+    }
+
+    function setBaseURI(string calldata baseURI) external onlyOwner {
+        _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function tokenURI(
+        uint256 tokenId
+    ) public view virtual override returns (string memory) {
+        if (ownerOf(tokenId) == address(0)) {
+            revert NonExistentTokenURI();
+        }
+        return
+            bytes(_baseTokenURI).length > 0
+                ? string(abi.encodePacked(_baseTokenURI, tokenId.toString()))
+                : "";
+    }
+
+    // ============ ERC-5192 ============
+
+    function locked(uint256 tokenId) external view returns (bool) {
+        _requireOwned(tokenId);
+        return !_unlocked[tokenId];
+    }
+
+    /**
+     * @dev Allows the next transfer of `tokenId`, after which the token is locked again
+     */
+    function unlock(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        _unlocked[tokenId] = true;
+        emit Unlocked(tokenId);
+    }
+
+    /**
+     * @dev Locks a token the issuer unlocked and that was not transferred yet
+     */
+    function lock(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        delete _unlocked[tokenId];
+        emit Locked(tokenId);
+    }
+
+    // ============ End of ERC-5192 ============
+
+    // The following functions are overrides required by Solidity.
+
+    function _update(
+        address to,
+        uint256 tokenId,
+        address auth
+    ) internal override(ERC721, ERC721Enumerable) returns (address) {
+        address from = _ownerOf(tokenId);
+
+        // Mints and burns are allowed, transfers need an unlock and lock the token again
+        if (from != address(0) && to != address(0)) {
+            if (!_unlocked[tokenId]) {
+                revert TokenLocked(tokenId);
+            }
+            delete _unlocked[tokenId];
+            emit Locked(tokenId);
+        } else if (to == address(0)) {
+            delete _unlocked[tokenId];
+        }
+
+        return super._update(to, tokenId, auth);
+    }
+
+    function _increaseBalance(
+        address account,
+        uint128 value
+    ) internal override(ERC721, ERC721Enumerable) {
+        super._increaseBalance(account, value);
+    }
+
+    function supportsInterface(
+        bytes4 interfaceId
+    ) public view override(ERC721, ERC721Enumerable) returns (bool) {
+        return
+            interfaceId == ERC4906_INTERFACE_ID ||
+            interfaceId == type(IERC5192).interfaceId ||
+            super.supportsInterface(interfaceId);
+    }
+}
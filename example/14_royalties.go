@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/client"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// This example demonstrates ERC-2981 royalties on resold certificates.
+// Marketplaces read the royalty of a sale with royaltyInfo and pay it to the receiver.
+//
+// Usage:
+//   go run 14_royalties.go
+//
+// What this script does:
+// 1. Connects to the network and creates an account
+// 2. Deploys LBBCertRoyalty with a 2.5% default royalty to the issuer
+// 3. Gives token 7 its own 10% royalty to an artist
+// 4. Queries the royalty of both tokens for a sale
+// 5. Clears the token royalty so token 7 falls back to the default
+//
+// Prerequisites:
+// - Schema must be deployed first (see 03_deploy_schema.go)
+// - Build the contract with `forge build` in contracts/, the SDK does not bundle its bytecode
+// - Account must have tokens for gas fees
+
+const (
+	contractName   = "MyCollectible"
+	contractSymbol = "COLL"
+	schemaName     = "myorg.lbbv01"
+
+	// Forge artifact of contracts/src/CertRoyalty.sol
+	artifactPath = "../contracts/out/CertRoyalty.sol/LBBCertRoyalty.json"
+
+	// Receiver of the royalty of token 7
+	artistAddress = "0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"
+
+	// For this example, we use the test mnemonic
+	exampleMnemonic = account.TestMnemonic
+)
+
+func main() {
+	fmt.Println("=== Step 14: Certificate Royalties (ERC-2981) ===")
+	fmt.Println()
+
+	// Step 1: Setup client and account
+	fmt.Println("Setting up connection...")
+	ctx := context.Background()
+	client, err := client.NewClient(ctx, false)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create client: %v", err))
+	}
+
+	acc, err := account.NewAccount(client, "myaccount", exampleMnemonic, "mypassword")
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create account: %v", err))
+	}
+
+	evmClient := evm.NewEVMClient(*acc)
+	fmt.Printf("Connected with account: %s\n", acc.GetEVMAddress().Hex())
+	fmt.Println()
+
+	// Step 2: Deploy with a default royalty
+	// Fees are in basis points of the sale price, 250 is 2.5%
+	fmt.Println("Deploying LBBCertRoyalty...")
+	contractAddress, tx, err := evmClient.DeployRoyaltyCertificateContract(artifactPath, contractName, contractSymbol, schemaName, &evm.RoyaltyConfig{
+		Receiver:       acc.GetEVMAddress(),
+		FeeBasisPoints: 250,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to deploy contract: %v", err))
+	}
+
+	if _, err := client.WaitForEVMTransaction(tx.Hash()); err != nil {
+		panic(fmt.Sprintf("Error waiting for deployment: %v", err))
+	}
+	fmt.Printf("Contract Address: %s\n", contractAddress.Hex())
+	fmt.Println()
+
+	// Step 3: Override the default royalty for one token
+	// The token does not need to be minted yet
+	fmt.Println("Setting a 10% royalty on token 7...")
+	tx, err = evmClient.SetTokenRoyalty(contractAddress, 7, evm.RoyaltyConfig{
+		Receiver:       common.HexToAddress(artistAddress),
+		FeeBasisPoints: 1_000,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to set token royalty: %v", err))
+	}
+
+	if _, err := client.WaitForEVMTransaction(tx.Hash()); err != nil {
+		panic(fmt.Sprintf("Error waiting for transaction: %v", err))
+	}
+	fmt.Println()
+
+	// Step 4: Query the royalty of a sale at 2 SIX (in asix)
+	salePrice, _ := new(big.Int).SetString("2000000000000000000", 10)
+	printRoyalty(evmClient, contractAddress, 1, salePrice)
+	printRoyalty(evmClient, contractAddress, 7, salePrice)
+
+	// Step 5: Clear the token royalty, token 7 falls back to the default
+	fmt.Println("Clearing the royalty of token 7...")
+	tx, err = evmClient.ClearTokenRoyalty(contractAddress, 7)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to clear token royalty: %v", err))
+	}
+
+	if _, err := client.WaitForEVMTransaction(tx.Hash()); err != nil {
+		panic(fmt.Sprintf("Error waiting for transaction: %v", err))
+	}
+	printRoyalty(evmClient, contractAddress, 7, salePrice)
+
+	fmt.Println("Next steps:")
+	fmt.Println("  • Mint certificates as usual (06_01_mint_nft.go)")
+	fmt.Println("  • Change or remove the default royalty with SetDefaultRoyalty and ClearDefaultRoyalty")
+	fmt.Println()
+}
+
+func printRoyalty(evmClient *evm.EVMClient, contractAddress common.Address, tokenID uint64, salePrice *big.Int) {
+	info, err := evmClient.RoyaltyInfo(contractAddress, tokenID, salePrice)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to query royalty: %v", err))
+	}
+
+	fmt.Printf("Token %d sold for %s asix:\n", tokenID, salePrice)
+	fmt.Printf("   Royalty Receiver: %s\n", info.Receiver.Hex())
+	fmt.Printf("   Royalty Amount:   %s asix\n", info.Amount)
+	fmt.Println()
+}
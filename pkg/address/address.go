@@ -0,0 +1,199 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/config"
+)
+
+// Format is the encoding an address string is written in
+type Format int
+
+const (
+	FormatUnknown Format = iota
+	// FormatBech32 is a Cosmos account address with the 6x prefix
+	FormatBech32
+	// FormatHex is a 0x-prefixed 20-byte EVM address
+	FormatHex
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatBech32:
+		return "bech32"
+	case FormatHex:
+		return "hex"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	ErrEmptyAddress    = errors.New("address cannot be empty")
+	ErrUnknownFormat   = errors.New("address is neither a 6x bech32 nor a 0x hex address")
+	ErrInvalidChecksum = errors.New("address has an invalid EIP-55 checksum")
+)
+
+// DetectFormat reports which format the address string is written in
+// It only looks at the shape of the string; use Validate to check it fully
+func DetectFormat(addr string) Format {
+	addr = strings.TrimSpace(addr)
+	switch {
+	case has0xPrefix(addr):
+		return FormatHex
+	case strings.HasPrefix(strings.ToLower(addr), config.AccountAddressPrefix+"1"):
+		return FormatBech32
+	default:
+		return FormatUnknown
+	}
+}
+
+// Validate checks that the address is a valid 6x bech32 or 0x hex address
+func Validate(addr string) error {
+	_, err := Parse(addr)
+	return err
+}
+
+// ValidateBech32 checks that the address is a valid bech32 account address with the 6x prefix
+func ValidateBech32(addr string) error {
+	_, err := parseBech32(strings.TrimSpace(addr))
+	return err
+}
+
+// ValidateHex checks that the address is a valid 0x hex address
+// Mixed-case input must carry a correct EIP-55 checksum
+func ValidateHex(addr string) error {
+	_, err := parseHex(strings.TrimSpace(addr))
+	return err
+}
+
+// Parse decodes an address in either format into its 20 bytes
+func Parse(addr string) (sdk.AccAddress, error) {
+	addr = strings.TrimSpace(addr)
+	if addr == "" {
+		return nil, ErrEmptyAddress
+	}
+
+	switch DetectFormat(addr) {
+	case FormatBech32:
+		return parseBech32(addr)
+	case FormatHex:
+		hexAddr, err := parseHex(addr)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.AccAddress(hexAddr.Bytes()), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, addr)
+	}
+}
+
+// ToBech32 converts an address in either format to its 6x bech32 form
+func ToBech32(addr string) (string, error) {
+	accAddr, err := Parse(addr)
+	if err != nil {
+		return "", err
+	}
+	return accAddr.String(), nil
+}
+
+// ToHex converts an address in either format to its EIP-55 checksummed 0x form
+func ToHex(addr string) (string, error) {
+	accAddr, err := Parse(addr)
+	if err != nil {
+		return "", err
+	}
+	return common.BytesToAddress(accAddr).Hex(), nil
+}
+
+// ToEVMAddress converts an address in either format to a common.Address
+func ToEVMAddress(addr string) (common.Address, error) {
+	accAddr, err := Parse(addr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(accAddr), nil
+}
+
+// ToAccAddress converts an address in either format to an sdk.AccAddress
+func ToAccAddress(addr string) (sdk.AccAddress, error) {
+	return Parse(addr)
+}
+
+// EVMToAccAddress returns the Cosmos account address with the same 20 bytes as the EVM address
+func EVMToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// AccToEVMAddress returns the EVM address with the same 20 bytes as the Cosmos account address
+func AccToEVMAddress(addr sdk.AccAddress) common.Address {
+	return common.BytesToAddress(addr)
+}
+
+// NormalizeHex validates a 0x hex address and returns its EIP-55 checksummed form
+func NormalizeHex(addr string) (string, error) {
+	hexAddr, err := parseHex(strings.TrimSpace(addr))
+	if err != nil {
+		return "", err
+	}
+	return hexAddr.Hex(), nil
+}
+
+func parseBech32(addr string) (sdk.AccAddress, error) {
+	if addr == "" {
+		return nil, ErrEmptyAddress
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bech32 address %q: %w", addr, err)
+	}
+
+	if hrp != config.AccountAddressPrefix {
+		return nil, fmt.Errorf("invalid bech32 address %q: expected prefix %q, got %q", addr, config.AccountAddressPrefix, hrp)
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, fmt.Errorf("invalid bech32 address %q: %w", addr, err)
+	}
+
+	if len(bz) != common.AddressLength {
+		return nil, fmt.Errorf("invalid bech32 address %q: expected %d bytes, got %d", addr, common.AddressLength, len(bz))
+	}
+
+	return sdk.AccAddress(bz), nil
+}
+
+func parseHex(addr string) (common.Address, error) {
+	if addr == "" {
+		return common.Address{}, ErrEmptyAddress
+	}
+
+	if !has0xPrefix(addr) {
+		return common.Address{}, fmt.Errorf("invalid hex address %q: missing 0x prefix", addr)
+	}
+
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, fmt.Errorf("invalid hex address %q: expected %d hex-encoded bytes", addr, common.AddressLength)
+	}
+
+	hexAddr := common.HexToAddress(addr)
+
+	// All-lowercase and all-uppercase addresses carry no checksum
+	body := addr[2:]
+	if body != strings.ToLower(body) && body != strings.ToUpper(body) && hexAddr.Hex() != addr {
+		return common.Address{}, fmt.Errorf("%w: %q, expected %q", ErrInvalidChecksum, addr, hexAddr.Hex())
+	}
+
+	return hexAddr, nil
+}
+
+func has0xPrefix(addr string) bool {
+	return len(addr) >= 2 && addr[0] == '0' && (addr[1] == 'x' || addr[1] == 'X')
+}
@@ -0,0 +1,121 @@
+package address_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
+)
+
+const (
+	testBech32   = "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
+	testHexLower = "0x0102030405060708090a0b0c0d0e0f1011121314"
+	testChecksum = "0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"
+)
+
+func TestDetectFormat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected address.Format
+	}{
+		{"Bech32 address", testBech32, address.FormatBech32},
+		{"Hex address", testHexLower, address.FormatHex},
+		{"Uppercase hex prefix", "0X0102030405060708090A0B0C0D0E0F1011121314", address.FormatHex},
+		{"Surrounding whitespace", "  " + testBech32 + " ", address.FormatBech32},
+		{"Other bech32 prefix", "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", address.FormatUnknown},
+		{"Empty string", "", address.FormatUnknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, address.DetectFormat(tc.input))
+		})
+	}
+}
+
+func TestConversion(t *testing.T) {
+	t.Run("Bech32 to hex and back", func(t *testing.T) {
+		hexAddr, err := address.ToHex(testBech32)
+		require.NoError(t, err)
+		assert.Equal(t, common.HexToAddress(testHexLower).Hex(), hexAddr)
+
+		bech32Addr, err := address.ToBech32(hexAddr)
+		require.NoError(t, err)
+		assert.Equal(t, testBech32, bech32Addr)
+	})
+
+	t.Run("Same 20 bytes in both forms", func(t *testing.T) {
+		evmAddr, err := address.ToEVMAddress(testBech32)
+		require.NoError(t, err)
+
+		accAddr := address.EVMToAccAddress(evmAddr)
+		assert.Equal(t, testBech32, accAddr.String())
+		assert.Equal(t, evmAddr, address.AccToEVMAddress(accAddr))
+	})
+
+	t.Run("Conversion is idempotent", func(t *testing.T) {
+		bech32Addr, err := address.ToBech32(testBech32)
+		require.NoError(t, err)
+		assert.Equal(t, testBech32, bech32Addr)
+
+		hexAddr, err := address.ToHex(testChecksum)
+		require.NoError(t, err)
+		assert.Equal(t, testChecksum, hexAddr)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"Valid bech32", testBech32, nil},
+		{"Valid lowercase hex", testHexLower, nil},
+		{"Valid checksummed hex", testChecksum, nil},
+		{"Valid uppercase hex", "0x" + strings.ToUpper(testChecksum[2:]), nil},
+		{"Empty", "", address.ErrEmptyAddress},
+		{"Bad checksum", "0x8A28fb81A084Ac7A276800957a19a6054BF86E4D", address.ErrInvalidChecksum},
+		{"Unknown format", "hello", address.ErrUnknownFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := address.Validate(tc.input)
+			if tc.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tc.wantErr), "expected %v, got %v", tc.wantErr, err)
+		})
+	}
+
+	t.Run("Bech32 with broken checksum", func(t *testing.T) {
+		broken := testBech32[:len(testBech32)-1] + "q"
+		assert.Error(t, address.ValidateBech32(broken))
+	})
+
+	t.Run("Hex with wrong length", func(t *testing.T) {
+		assert.Error(t, address.ValidateHex("0x0102"))
+	})
+
+	t.Run("Hex without prefix", func(t *testing.T) {
+		assert.Error(t, address.ValidateHex(testHexLower[2:]))
+	})
+}
+
+func TestNormalizeHex(t *testing.T) {
+	normalized, err := address.NormalizeHex(strings.ToLower(testChecksum))
+	require.NoError(t, err)
+	assert.Equal(t, testChecksum, normalized)
+
+	_, err = address.NormalizeHex(testBech32)
+	assert.Error(t, err, "Should reject bech32 input")
+}
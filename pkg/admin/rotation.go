@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+// Rotation moves a certificate collection, its Cosmos schema and its EVM contract, to a new owner
+// The Cosmos and EVM keys of an account differ, so both new owner addresses are needed
+type Rotation struct {
+	NFTSchemaCode   string
+	ContractAddress common.Address
+	NewCosmosOwner  string
+	NewEVMOwner     common.Address
+}
+
+// RotationResult holds the transactions of a rotation, a step that was already done has none
+type RotationResult struct {
+	ContractReceipt *types.Receipt
+	SchemaResponse  *sdk.TxResponse
+}
+
+// NewRotation returns a Rotation handing the collection to both addresses of newOwner
+func NewRotation(nftSchemaCode string, contractAddress common.Address, newOwner account.Account) Rotation {
+	return Rotation{
+		NFTSchemaCode:   nftSchemaCode,
+		ContractAddress: contractAddress,
+		NewCosmosOwner:  newOwner.GetCosmosAddress().String(),
+		NewEVMOwner:     newOwner.GetEVMAddress(),
+	}
+}
+
+func (r Rotation) Validate() error {
+	var errs []error
+
+	if r.NFTSchemaCode == "" {
+		errs = append(errs, errors.New("schema code cannot be empty"))
+	}
+
+	if r.ContractAddress == (common.Address{}) {
+		errs = append(errs, errors.New("contract address cannot be the zero address"))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.NewCosmosOwner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid new cosmos owner %s: %w", r.NewCosmosOwner, err))
+	}
+
+	if r.NewEVMOwner == (common.Address{}) {
+		errs = append(errs, errors.New("new EVM owner cannot be the zero address"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// RotateOwnership moves the contract and the schema of rotation from current to the new owner and checks both on-chain
+// Both owners are checked before anything is sent. A step already done is skipped,
+// so a rotation interrupted half way is finished by running it again with the same current key
+func RotateOwnership(current account.Account, rotation Rotation) (*RotationResult, error) {
+	if err := rotation.Validate(); err != nil {
+		return nil, err
+	}
+
+	evmClient := evm.NewEVMClient(current)
+	metadataMsg, err := metadata.NewMetadataMsg(current, rotation.NFTSchemaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	contractOwner, schemaOwner, err := owners(evmClient, metadataMsg, rotation)
+	if err != nil {
+		return nil, err
+	}
+
+	currentCosmos := current.GetCosmosAddress().String()
+	currentEVM := current.GetEVMAddress()
+
+	if contractOwner != currentEVM && contractOwner != rotation.NewEVMOwner {
+		return nil, fmt.Errorf("contract %s is owned by %s, not by %s", rotation.ContractAddress.Hex(), contractOwner.Hex(), currentEVM.Hex())
+	}
+
+	if schemaOwner != currentCosmos && schemaOwner != rotation.NewCosmosOwner {
+		return nil, fmt.Errorf("schema %s is owned by %s, not by %s", rotation.NFTSchemaCode, schemaOwner, currentCosmos)
+	}
+
+	result := &RotationResult{}
+
+	if contractOwner == currentEVM && contractOwner != rotation.NewEVMOwner {
+		result.ContractReceipt, err = evmClient.TransferContractOwnershipAndWait(rotation.ContractAddress, rotation.NewEVMOwner)
+		if err != nil {
+			return result, fmt.Errorf("failed to transfer contract ownership, nothing was rotated: %w", err)
+		}
+	}
+
+	if schemaOwner == currentCosmos && schemaOwner != rotation.NewCosmosOwner {
+		result.SchemaResponse, err = metadataMsg.ChangeSchemaOwnerAndWait(rotation.NewCosmosOwner)
+		if err != nil {
+			return result, fmt.Errorf("contract ownership moved but schema ownership did not, rerun the rotation to finish: %w", err)
+		}
+	}
+
+	contractOwner, schemaOwner, err = owners(evmClient, metadataMsg, rotation)
+	if err != nil {
+		return result, fmt.Errorf("failed to verify rotation: %w", err)
+	}
+
+	if contractOwner != rotation.NewEVMOwner || schemaOwner != rotation.NewCosmosOwner {
+		return result, fmt.Errorf("rotation not applied: contract owner is %s, schema owner is %s", contractOwner.Hex(), schemaOwner)
+	}
+
+	return result, nil
+}
+
+func owners(evmClient *evm.EVMClient, metadataMsg *metadata.MetadataMsg, rotation Rotation) (common.Address, string, error) {
+	contractOwner, err := evmClient.ContractOwner(rotation.ContractAddress)
+	if err != nil {
+		return common.Address{}, "", err
+	}
+
+	schema, err := metadataMsg.GetNFTSchema(rotation.NFTSchemaCode)
+	if err != nil {
+		return common.Address{}, "", fmt.Errorf("failed to get schema %s: %w", rotation.NFTSchemaCode, err)
+	}
+
+	return contractOwner, schema.Owner, nil
+}
@@ -0,0 +1,45 @@
+package admin_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/admin"
+)
+
+func TestRotationValidate(t *testing.T) {
+	valid := admin.Rotation{
+		NFTSchemaCode:   "sixprotocol.lbb_cert",
+		ContractAddress: common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314"),
+		NewCosmosOwner:  "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw",
+		NewEVMOwner:     common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"),
+	}
+
+	testCases := []struct {
+		name    string
+		modify  func(r *admin.Rotation)
+		wantErr string
+	}{
+		{"Valid rotation", func(r *admin.Rotation) {}, ""},
+		{"Missing schema code", func(r *admin.Rotation) { r.NFTSchemaCode = "" }, "schema code"},
+		{"Zero contract address", func(r *admin.Rotation) { r.ContractAddress = common.Address{} }, "contract address"},
+		{"Hex cosmos owner", func(r *admin.Rotation) { r.NewCosmosOwner = valid.NewEVMOwner.Hex() }, "invalid new cosmos owner"},
+		{"Zero EVM owner", func(r *admin.Rotation) { r.NewEVMOwner = common.Address{} }, "new EVM owner"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rotation := valid
+			tc.modify(&rotation)
+
+			err := rotation.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
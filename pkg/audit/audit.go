@@ -0,0 +1,274 @@
+package audit
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+// statusAttribute is the on-chain attribute the freeze_cert and unfreeze_cert actions switch
+const statusAttribute = "status"
+
+// FindingKind is a kind of inconsistency between the metadata of a schema and the tokens of its contract
+type FindingKind string
+
+const (
+	FindingMetadataWithoutNFT       FindingKind = "metadata_without_nft"
+	FindingNFTWithoutMetadata       FindingKind = "nft_without_metadata"
+	FindingBurnedWithActiveMetadata FindingKind = "burned_with_active_metadata"
+	FindingOwnerMismatch            FindingKind = "owner_mismatch"
+	FindingInvalidTokenID           FindingKind = "invalid_token_id"
+)
+
+// Finding is one inconsistency of an audit
+type Finding struct {
+	Kind          FindingKind `json:"kind"`
+	TokenID       string      `json:"token_id"`
+	MetadataOwner string      `json:"metadata_owner,omitempty"`
+	NFTOwner      string      `json:"nft_owner,omitempty"`
+	// ExpectedOwner is the EVM address the metadata owner maps to
+	ExpectedOwner string `json:"expected_owner,omitempty"`
+	// MetadataStatus is the status attribute of the metadata, TCI when active and TCL when frozen
+	MetadataStatus string `json:"metadata_status,omitempty"`
+	Detail         string `json:"detail,omitempty"`
+}
+
+// Config selects the schema and contract to compare
+type Config struct {
+	NFTSchemaCode   string
+	ContractAddress common.Address
+	// FromBlock is where burns are looked for, usually the block the contract was deployed at
+	FromBlock uint64
+	// SkipOwnerCheck leaves out owner mismatches, for collections whose metadata owner is not the token holder
+	SkipOwnerCheck bool
+}
+
+func (c Config) Validate() error {
+	if c.NFTSchemaCode == "" {
+		return fmt.Errorf("schema code cannot be empty")
+	}
+
+	if c.ContractAddress == (common.Address{}) {
+		return fmt.Errorf("contract address cannot be the zero address")
+	}
+
+	return nil
+}
+
+// MetadataSource lists every metadata of a schema
+type MetadataSource interface {
+	NFTCollection(nftSchemaCode string) ([]nftmngrtypes.NftData, error)
+}
+
+// TokenSource lists the tokens of a contract and the tokens burned on it
+type TokenSource interface {
+	Tokens(contractAddress common.Address) ([]evm.TokenInfo, error)
+	BurnedTokens(contractAddress common.Address, fromBlock uint64) ([]*big.Int, error)
+}
+
+// Auditor compares the metadata of a schema with the tokens of its contract
+type Auditor struct {
+	metadata MetadataSource
+	tokens   TokenSource
+	aliases  map[string]common.Address
+	now      func() time.Time
+}
+
+func NewAuditorWithSources(metadataSource MetadataSource, tokenSource TokenSource) (*Auditor, error) {
+	if metadataSource == nil || tokenSource == nil {
+		return nil, fmt.Errorf("metadata and token sources cannot be nil")
+	}
+
+	return &Auditor{
+		metadata: metadataSource,
+		tokens:   tokenSource,
+		aliases:  make(map[string]common.Address),
+		now:      time.Now,
+	}, nil
+}
+
+// WithOwnerAlias returns a new Auditor expecting the NFTs of metadata owned by cosmosOwner to be held by evmOwner
+// The Cosmos and EVM keys of an account differ, so an issuer's metadata and tokens only match through an alias
+func (a *Auditor) WithOwnerAlias(cosmosOwner string, evmOwner common.Address) *Auditor {
+	newAuditor := *a
+	newAuditor.aliases = make(map[string]common.Address, len(a.aliases)+1)
+	for owner, alias := range a.aliases {
+		newAuditor.aliases[owner] = alias
+	}
+	newAuditor.aliases[cosmosOwner] = evmOwner
+	return &newAuditor
+}
+
+// Audit walks every metadata of the schema and every token of the contract and reports what does not match
+func (a *Auditor) Audit(config Config) (*Report, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	collection, err := a.metadata.NFTCollection(config.NFTSchemaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := a.tokens.Tokens(config.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	burnedTokens, err := a.tokens.BurnedTokens(config.ContractAddress, config.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		NFTSchemaCode:   config.NFTSchemaCode,
+		ContractAddress: config.ContractAddress,
+		GeneratedAt:     a.now().UTC(),
+		MetadataCount:   len(collection),
+		TokenCount:      len(tokens),
+		BurnedCount:     len(burnedTokens),
+		Findings:        []Finding{},
+	}
+
+	minted := make(map[string]evm.TokenInfo, len(tokens))
+	for _, token := range tokens {
+		minted[token.TokenID.String()] = token
+	}
+
+	burned := make(map[string]bool, len(burnedTokens))
+	for _, tokenID := range burnedTokens {
+		burned[tokenID.String()] = true
+	}
+
+	described := make(map[string]bool, len(collection))
+	for _, nftData := range collection {
+		tokenID, ok := new(big.Int).SetString(nftData.TokenId, 10)
+		if !ok || tokenID.Sign() < 0 {
+			report.add(Finding{
+				Kind:          FindingInvalidTokenID,
+				TokenID:       nftData.TokenId,
+				MetadataOwner: nftData.TokenOwner,
+				Detail:        "metadata token ID is not a uint256",
+			})
+			continue
+		}
+
+		key := tokenID.String()
+		described[key] = true
+		status := metadataStatus(nftData)
+
+		token, isMinted := minted[key]
+		switch {
+		case !isMinted && burned[key]:
+			// A frozen certificate is the expected state after a burn
+			if status != metadata.InactiveCertStr {
+				report.add(Finding{
+					Kind:           FindingBurnedWithActiveMetadata,
+					TokenID:        key,
+					MetadataOwner:  nftData.TokenOwner,
+					MetadataStatus: status,
+				})
+			}
+		case !isMinted:
+			finding := Finding{
+				Kind:           FindingMetadataWithoutNFT,
+				TokenID:        key,
+				MetadataOwner:  nftData.TokenOwner,
+				MetadataStatus: status,
+			}
+			if expected, err := a.expectedOwner(nftData.TokenOwner); err == nil {
+				finding.ExpectedOwner = expected.Hex()
+			}
+			report.add(finding)
+		case !config.SkipOwnerCheck:
+			expected, err := a.expectedOwner(nftData.TokenOwner)
+			if err != nil {
+				report.add(Finding{
+					Kind:          FindingOwnerMismatch,
+					TokenID:       key,
+					MetadataOwner: nftData.TokenOwner,
+					NFTOwner:      token.Owner.Hex(),
+					Detail:        err.Error(),
+				})
+				continue
+			}
+
+			if expected != token.Owner {
+				report.add(Finding{
+					Kind:           FindingOwnerMismatch,
+					TokenID:        key,
+					MetadataOwner:  nftData.TokenOwner,
+					NFTOwner:       token.Owner.Hex(),
+					ExpectedOwner:  expected.Hex(),
+					MetadataStatus: status,
+				})
+			}
+		}
+	}
+
+	for key, token := range minted {
+		if !described[key] {
+			report.add(Finding{
+				Kind:     FindingNFTWithoutMetadata,
+				TokenID:  key,
+				NFTOwner: token.Owner.Hex(),
+			})
+		}
+	}
+
+	report.sort()
+	return report, nil
+}
+
+// expectedOwner returns the EVM address that should hold the NFT of metadata owned by owner
+func (a *Auditor) expectedOwner(owner string) (common.Address, error) {
+	if alias, ok := a.aliases[owner]; ok {
+		return alias, nil
+	}
+
+	expected, err := address.ToEVMAddress(owner)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("metadata owner is not an address: %w", err)
+	}
+
+	return expected, nil
+}
+
+func metadataStatus(nftData nftmngrtypes.NftData) string {
+	for _, attribute := range nftData.OnchainAttributes {
+		if attribute != nil && attribute.Name == statusAttribute {
+			return attribute.GetStringAttributeValue().GetValue()
+		}
+	}
+	return ""
+}
+
+// compareTokenIDs orders decimal token IDs numerically
+func compareTokenIDs(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+func (r *Report) add(finding Finding) {
+	r.Findings = append(r.Findings, finding)
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		if c := compareTokenIDs(r.Findings[i].TokenID, r.Findings[j].TokenID); c != 0 {
+			return c < 0
+		}
+		return r.Findings[i].Kind < r.Findings[j].Kind
+	})
+}
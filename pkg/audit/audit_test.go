@@ -0,0 +1,270 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/audit"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/issuance"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+const (
+	testSchema = "sixprotocol.lbb_cert"
+	// testOwner converts to testHolder
+	testOwner  = "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
+	testIssuer = "6x1issuer"
+)
+
+var (
+	testContract   = common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	testHolder     = common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	testIssuerEVM  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testStranger   = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	testActive     = metadata.ActiveCertStr
+	testFrozen     = metadata.InactiveCertStr
+	testNoStatus   = ""
+	testBadTokenID = "cert-1"
+)
+
+type fakeSources struct {
+	collection []nftmngrtypes.NftData
+	tokens     []evm.TokenInfo
+	burned     []*big.Int
+}
+
+func (f *fakeSources) NFTCollection(string) ([]nftmngrtypes.NftData, error) {
+	return f.collection, nil
+}
+
+func (f *fakeSources) Tokens(common.Address) ([]evm.TokenInfo, error) {
+	return f.tokens, nil
+}
+
+func (f *fakeSources) BurnedTokens(common.Address, uint64) ([]*big.Int, error) {
+	return f.burned, nil
+}
+
+func nftData(tokenID, owner, status string) nftmngrtypes.NftData {
+	data := nftmngrtypes.NftData{
+		NftSchemaCode: testSchema,
+		TokenId:       tokenID,
+		TokenOwner:    owner,
+	}
+	if status != "" {
+		data.OnchainAttributes = []*nftmngrtypes.NftAttributeValue{{
+			Name: "status",
+			Value: &nftmngrtypes.NftAttributeValue_StringAttributeValue{
+				StringAttributeValue: &nftmngrtypes.StringAttributeValue{Value: status},
+			},
+		}}
+	}
+	return data
+}
+
+func token(tokenID int64, owner common.Address) evm.TokenInfo {
+	return evm.TokenInfo{TokenID: big.NewInt(tokenID), Owner: owner}
+}
+
+func newTestSources() *fakeSources {
+	return &fakeSources{
+		collection: []nftmngrtypes.NftData{
+			nftData("1", testOwner, testActive),    // consistent
+			nftData("2", testIssuer, testActive),   // consistent through the issuer alias
+			nftData("3", testOwner, testActive),    // no NFT
+			nftData("5", testOwner, testActive),    // burned, still active
+			nftData("6", testOwner, testFrozen),    // burned and frozen
+			nftData("10", testOwner, testNoStatus), // held by someone else
+			nftData(testBadTokenID, testOwner, testActive),
+		},
+		tokens: []evm.TokenInfo{
+			token(1, testHolder),
+			token(2, testIssuerEVM),
+			token(4, testHolder),
+			token(10, testStranger),
+		},
+		burned: []*big.Int{big.NewInt(5), big.NewInt(6)},
+	}
+}
+
+func newTestAuditor(t *testing.T, sources *fakeSources) *audit.Auditor {
+	auditor, err := audit.NewAuditorWithSources(sources, sources)
+	require.NoError(t, err)
+	return auditor.WithOwnerAlias(testIssuer, testIssuerEVM)
+}
+
+func testConfig() audit.Config {
+	return audit.Config{NFTSchemaCode: testSchema, ContractAddress: testContract}
+}
+
+func TestAudit(t *testing.T) {
+	t.Run("Reports every inconsistency", func(t *testing.T) {
+		report, err := newTestAuditor(t, newTestSources()).Audit(testConfig())
+		require.NoError(t, err)
+
+		assert.False(t, report.OK())
+		assert.Equal(t, 7, report.MetadataCount)
+		assert.Equal(t, 4, report.TokenCount)
+		assert.Equal(t, 2, report.BurnedCount)
+
+		kinds := make(map[string]audit.FindingKind)
+		for _, finding := range report.Findings {
+			kinds[finding.TokenID] = finding.Kind
+		}
+		assert.Equal(t, map[string]audit.FindingKind{
+			"3":            audit.FindingMetadataWithoutNFT,
+			"4":            audit.FindingNFTWithoutMetadata,
+			"5":            audit.FindingBurnedWithActiveMetadata,
+			"10":           audit.FindingOwnerMismatch,
+			testBadTokenID: audit.FindingInvalidTokenID,
+		}, kinds)
+
+		// Findings are ordered by numeric token ID
+		require.Len(t, report.Findings, 5)
+		assert.Equal(t, "3", report.Findings[0].TokenID)
+		assert.Equal(t, "10", report.Findings[3].TokenID)
+
+		mismatch := report.Findings[3]
+		assert.Equal(t, testStranger.Hex(), mismatch.NFTOwner)
+		assert.Equal(t, testHolder.Hex(), mismatch.ExpectedOwner)
+	})
+
+	t.Run("Issuer without alias is a mismatch", func(t *testing.T) {
+		auditor, err := audit.NewAuditorWithSources(newTestSources(), newTestSources())
+		require.NoError(t, err)
+
+		report, err := auditor.Audit(testConfig())
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Count(audit.FindingOwnerMismatch))
+	})
+
+	t.Run("Skip owner check", func(t *testing.T) {
+		config := testConfig()
+		config.SkipOwnerCheck = true
+
+		report, err := newTestAuditor(t, newTestSources()).Audit(config)
+		require.NoError(t, err)
+		assert.Zero(t, report.Count(audit.FindingOwnerMismatch))
+	})
+
+	t.Run("Consistent layers", func(t *testing.T) {
+		sources := &fakeSources{
+			collection: []nftmngrtypes.NftData{nftData("1", testOwner, testActive)},
+			tokens:     []evm.TokenInfo{token(1, testHolder)},
+		}
+
+		report, err := newTestAuditor(t, sources).Audit(testConfig())
+		require.NoError(t, err)
+		assert.True(t, report.OK())
+		assert.Empty(t, report.RepairPlan().Actions)
+	})
+
+	t.Run("Invalid config", func(t *testing.T) {
+		_, err := newTestAuditor(t, newTestSources()).Audit(audit.Config{NFTSchemaCode: testSchema})
+		assert.Error(t, err)
+	})
+}
+
+func TestReportOutput(t *testing.T) {
+	report, err := newTestAuditor(t, newTestSources()).Audit(testConfig())
+	require.NoError(t, err)
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJSON(&buf))
+
+		var decoded audit.Report
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, report.Findings, decoded.Findings)
+		assert.Equal(t, testContract, decoded.ContractAddress)
+	})
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteCSV(&buf))
+
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, len(report.Findings)+1)
+		assert.Equal(t, "kind", rows[0][0])
+		assert.Equal(t, []string{string(audit.FindingMetadataWithoutNFT), "3"}, rows[1][:2])
+	})
+}
+
+// fakeLayers records what a repair sends to both layers
+type fakeLayers struct {
+	metadata map[string]bool
+	frozen   map[string]bool
+	minted   map[uint64]common.Address
+}
+
+func (f *fakeLayers) MetadataExists(_, tokenID string) (bool, error) {
+	return f.metadata[tokenID], nil
+}
+
+func (f *fakeLayers) CreateMetadata(_, tokenID string, _ *metadata.CertificateInfo) (string, error) {
+	f.metadata[tokenID] = true
+	return "COSMOSHASH", nil
+}
+
+func (f *fakeLayers) FreezeMetadata(_, tokenID string) (string, error) {
+	f.frozen[tokenID] = true
+	return "FREEZEHASH", nil
+}
+
+func (f *fakeLayers) IsMinted(_ common.Address, tokenID uint64) (bool, error) {
+	_, minted := f.minted[tokenID]
+	return minted, nil
+}
+
+func (f *fakeLayers) Mint(_ common.Address, tokenID uint64, recipient common.Address) (common.Hash, error) {
+	f.minted[tokenID] = recipient
+	return common.BigToHash(common.Big1), nil
+}
+
+func (f *fakeLayers) Burn(_ common.Address, tokenID uint64) (common.Hash, error) {
+	delete(f.minted, tokenID)
+	return common.BigToHash(common.Big2), nil
+}
+
+func (f *fakeLayers) DefaultRecipient() common.Address {
+	return testIssuerEVM
+}
+
+func TestRepairPlan(t *testing.T) {
+	report, err := newTestAuditor(t, newTestSources()).Audit(testConfig())
+	require.NoError(t, err)
+
+	plan := report.RepairPlan()
+	require.Len(t, plan.Actions, 3)
+	assert.Equal(t, audit.RepairMintNFT, plan.Actions[0].Kind)
+	assert.Equal(t, testHolder, plan.Actions[0].Recipient)
+	assert.Equal(t, audit.RepairCreateMetadata, plan.Actions[1].Kind)
+	assert.Equal(t, audit.RepairFreezeMetadata, plan.Actions[2].Kind)
+	assert.Len(t, plan.Manual, 2)
+
+	// The layers already hold what the audit found, the issuer only sends the missing leg
+	layers := &fakeLayers{
+		metadata: map[string]bool{"3": true},
+		frozen:   make(map[string]bool),
+		minted:   map[uint64]common.Address{4: testHolder},
+	}
+	issuer, err := issuance.NewIssuerWithLayers(layers, layers, issuance.NewMemoryJournal())
+	require.NoError(t, err)
+
+	require.NoError(t, plan.Apply(context.Background(), issuer, layers))
+	assert.Equal(t, testHolder, layers.minted[3])
+	assert.Equal(t, testHolder, layers.minted[4], "Existing token should not be minted again")
+	assert.True(t, layers.metadata["4"])
+	assert.True(t, layers.frozen["5"])
+}
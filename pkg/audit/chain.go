@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+// DefaultMetadataPageSize is the number of metadata read per collection query
+const DefaultMetadataPageSize = uint64(100)
+
+var (
+	_ MetadataSource = (*ChainMetadata)(nil)
+	_ TokenSource    = (*ChainTokens)(nil)
+)
+
+// ChainMetadata reads the metadata of a schema from the Cosmos layer
+type ChainMetadata struct {
+	metadata *metadata.Metadata
+	pageSize uint64
+}
+
+func NewChainMetadata(a account.Account) *ChainMetadata {
+	return &ChainMetadata{
+		metadata: metadata.NewMetadata(a),
+		pageSize: DefaultMetadataPageSize,
+	}
+}
+
+func (c *ChainMetadata) NFTCollection(nftSchemaCode string) ([]nftmngrtypes.NftData, error) {
+	var (
+		collection []nftmngrtypes.NftData
+		pageKey    []byte
+	)
+	for {
+		page, nextKey, err := c.metadata.GetNFTCollection(nftSchemaCode, pageKey, c.pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata of %s: %w", nftSchemaCode, err)
+		}
+
+		collection = append(collection, page...)
+		if len(nextKey) == 0 {
+			return collection, nil
+		}
+		pageKey = nextKey
+	}
+}
+
+// ChainTokens reads the tokens of a contract from the EVM layer
+type ChainTokens struct {
+	evmClient *evm.EVMClient
+}
+
+func NewChainTokens(evmClient *evm.EVMClient) *ChainTokens {
+	return &ChainTokens{
+		evmClient: evmClient,
+	}
+}
+
+// Tokens returns every token of the contract, read at one block through ERC721Enumerable
+func (c *ChainTokens) Tokens(contractAddress common.Address) ([]evm.TokenInfo, error) {
+	it, err := c.evmClient.TokenSnapshot(contractAddress, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []evm.TokenInfo
+	for it.Next() {
+		tokens = append(tokens, it.Token())
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tokens of %s: %w", contractAddress.Hex(), err)
+	}
+
+	return tokens, nil
+}
+
+func (c *ChainTokens) BurnedTokens(contractAddress common.Address, fromBlock uint64) ([]*big.Int, error) {
+	return c.evmClient.BurnedTokens(contractAddress, fromBlock)
+}
+
+// NewAuditor returns an Auditor reading both layers with a
+// Metadata owned by the Cosmos address of a is expected to be held by its EVM address
+func NewAuditor(a account.Account) *Auditor {
+	auditor, _ := NewAuditorWithSources(NewChainMetadata(a), NewChainTokens(evm.NewEVMClient(a)))
+	return auditor.WithOwnerAlias(a.GetCosmosAddress().String(), a.GetEVMAddress())
+}
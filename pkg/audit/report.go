@@ -0,0 +1,185 @@
+package audit
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/issuance"
+)
+
+// Report is the outcome of an audit
+type Report struct {
+	NFTSchemaCode   string         `json:"nft_schema_code"`
+	ContractAddress common.Address `json:"contract_address"`
+	GeneratedAt     time.Time      `json:"generated_at"`
+	MetadataCount   int            `json:"metadata_count"`
+	TokenCount      int            `json:"token_count"`
+	BurnedCount     int            `json:"burned_count"`
+	Findings        []Finding      `json:"findings"`
+}
+
+// OK reports whether both layers are consistent
+func (r *Report) OK() bool {
+	return len(r.Findings) == 0
+}
+
+// Count returns the number of findings of a kind
+func (r *Report) Count(kind FindingKind) int {
+	count := 0
+	for _, finding := range r.Findings {
+		if finding.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes one row per finding
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	rows := [][]string{{"kind", "token_id", "metadata_owner", "nft_owner", "expected_owner", "metadata_status", "detail"}}
+	for _, finding := range r.Findings {
+		rows = append(rows, []string{
+			string(finding.Kind),
+			finding.TokenID,
+			finding.MetadataOwner,
+			finding.NFTOwner,
+			finding.ExpectedOwner,
+			finding.MetadataStatus,
+			finding.Detail,
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// RepairKind is what a repair action does
+type RepairKind string
+
+const (
+	RepairMintNFT        RepairKind = "mint_nft"
+	RepairCreateMetadata RepairKind = "create_metadata"
+	RepairFreezeMetadata RepairKind = "freeze_metadata"
+)
+
+// RepairAction fixes one finding
+type RepairAction struct {
+	Kind    RepairKind `json:"kind"`
+	TokenID string     `json:"token_id"`
+	// Recipient receives the NFT of a RepairMintNFT, the issuer when empty
+	Recipient common.Address `json:"recipient,omitempty"`
+	Finding   Finding        `json:"finding"`
+}
+
+// RepairPlan lists the actions fixing the findings of a report
+type RepairPlan struct {
+	NFTSchemaCode   string         `json:"nft_schema_code"`
+	ContractAddress common.Address `json:"contract_address"`
+	Actions         []RepairAction `json:"actions"`
+	// Manual are the findings no action can fix safely, owner mismatches and invalid token IDs need a decision
+	Manual []Finding `json:"manual"`
+}
+
+// RepairPlan returns the actions fixing the findings of the report, nothing is sent until the plan is applied
+func (r *Report) RepairPlan() *RepairPlan {
+	plan := &RepairPlan{
+		NFTSchemaCode:   r.NFTSchemaCode,
+		ContractAddress: r.ContractAddress,
+		Actions:         []RepairAction{},
+		Manual:          []Finding{},
+	}
+
+	for _, finding := range r.Findings {
+		switch finding.Kind {
+		case FindingMetadataWithoutNFT:
+			action := RepairAction{Kind: RepairMintNFT, TokenID: finding.TokenID, Finding: finding}
+			if common.IsHexAddress(finding.ExpectedOwner) {
+				action.Recipient = common.HexToAddress(finding.ExpectedOwner)
+			}
+			plan.Actions = append(plan.Actions, action)
+		case FindingNFTWithoutMetadata:
+			plan.Actions = append(plan.Actions, RepairAction{Kind: RepairCreateMetadata, TokenID: finding.TokenID, Finding: finding})
+		case FindingBurnedWithActiveMetadata:
+			plan.Actions = append(plan.Actions, RepairAction{Kind: RepairFreezeMetadata, TokenID: finding.TokenID, Finding: finding})
+		default:
+			plan.Manual = append(plan.Manual, finding)
+		}
+	}
+
+	return plan
+}
+
+func (p *RepairPlan) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(p); err != nil {
+		return fmt.Errorf("failed to write repair plan: %w", err)
+	}
+	return nil
+}
+
+// Apply runs the actions of the plan
+// Missing NFTs and metadata go through issuer, which finds the leg already on-chain and only sends the other one.
+// Burned tokens are frozen through metadataLayer. It returns the errors of the actions that failed
+func (p *RepairPlan) Apply(ctx context.Context, issuer *issuance.Issuer, metadataLayer issuance.MetadataLayer) error {
+	if issuer == nil || metadataLayer == nil {
+		return fmt.Errorf("issuer and metadata layer cannot be nil")
+	}
+
+	var errs []error
+	for _, action := range p.Actions {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		if err := p.apply(ctx, issuer, metadataLayer, action); err != nil {
+			errs = append(errs, fmt.Errorf("%s of token %s: %w", action.Kind, action.TokenID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *RepairPlan) apply(ctx context.Context, issuer *issuance.Issuer, metadataLayer issuance.MetadataLayer, action RepairAction) error {
+	switch action.Kind {
+	case RepairMintNFT, RepairCreateMetadata:
+		tokenID, err := strconv.ParseUint(action.TokenID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("token ID does not fit the issuer: %w", err)
+		}
+
+		_, err = issuer.IssueCertificate(ctx, issuance.Spec{
+			NFTSchemaCode:   p.NFTSchemaCode,
+			ContractAddress: p.ContractAddress,
+			TokenID:         tokenID,
+			Recipient:       action.Recipient,
+		})
+		return err
+	case RepairFreezeMetadata:
+		_, err := metadataLayer.FreezeMetadata(p.NFTSchemaCode, action.TokenID)
+		return err
+	default:
+		return fmt.Errorf("unknown repair action %q", action.Kind)
+	}
+}
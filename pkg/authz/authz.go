@@ -0,0 +1,121 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+
+	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+)
+
+// OperatorMsgTypeURLs are the nftmngr messages a schema owner delegates to an operator key
+var OperatorMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&nftmngrtypes.MsgPerformActionByAdmin{}),
+	sdk.MsgTypeURL(&nftmngrtypes.MsgCreateMetadata{}),
+}
+
+type Authz struct {
+	account account.Account
+}
+
+type AuthzI interface {
+	GetGrants(granter, grantee, msgTypeURL string) ([]*authztypes.Grant, error)
+	GetGranterGrants(granter string) ([]*authztypes.GrantAuthorization, error)
+	GetGranteeGrants(grantee string) ([]*authztypes.GrantAuthorization, error)
+	IsOperator(granter, grantee string) (bool, error)
+	GetAccount() account.Account
+}
+
+var _ AuthzI = (*Authz)(nil)
+
+func NewAuthz(acc account.Account) *Authz {
+	return &Authz{
+		account: acc,
+	}
+}
+
+func (a *Authz) GetAccount() account.Account {
+	return a.account
+}
+
+// GetGrants retrieves the grants from granter to grantee
+// An empty msgTypeURL returns the grants for every message type
+func (a *Authz) GetGrants(granter, grantee, msgTypeURL string) ([]*authztypes.Grant, error) {
+	goCtx := a.account.GetClient().GetContext()
+	clientCtx := a.account.GetClient().GetClientCTX()
+	queryClient := authztypes.NewQueryClient(clientCtx)
+
+	res, err := queryClient.Grants(goCtx, &authztypes.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	})
+	if err != nil {
+		return []*authztypes.Grant{}, err
+	}
+
+	return res.Grants, nil
+}
+
+// GetGranterGrants retrieves every grant issued by the granter
+func (a *Authz) GetGranterGrants(granter string) ([]*authztypes.GrantAuthorization, error) {
+	goCtx := a.account.GetClient().GetContext()
+	clientCtx := a.account.GetClient().GetClientCTX()
+	queryClient := authztypes.NewQueryClient(clientCtx)
+
+	res, err := queryClient.GranterGrants(goCtx, &authztypes.QueryGranterGrantsRequest{
+		Granter: granter,
+	})
+	if err != nil {
+		return []*authztypes.GrantAuthorization{}, err
+	}
+
+	return res.Grants, nil
+}
+
+// GetGranteeGrants retrieves every grant received by the grantee
+func (a *Authz) GetGranteeGrants(grantee string) ([]*authztypes.GrantAuthorization, error) {
+	goCtx := a.account.GetClient().GetContext()
+	clientCtx := a.account.GetClient().GetClientCTX()
+	queryClient := authztypes.NewQueryClient(clientCtx)
+
+	res, err := queryClient.GranteeGrants(goCtx, &authztypes.QueryGranteeGrantsRequest{
+		Grantee: grantee,
+	})
+	if err != nil {
+		return []*authztypes.GrantAuthorization{}, err
+	}
+
+	return res.Grants, nil
+}
+
+// IsOperator reports whether the grantee holds an unexpired grant for every operator message type
+func (a *Authz) IsOperator(granter, grantee string) (bool, error) {
+	now := time.Now()
+
+	for _, msgTypeURL := range OperatorMsgTypeURLs {
+		grants, err := a.GetGrants(granter, grantee, msgTypeURL)
+		if err != nil {
+			return false, fmt.Errorf("failed to query grants for %s: %w", msgTypeURL, err)
+		}
+
+		if !hasActiveGrant(grants, now) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func hasActiveGrant(grants []*authztypes.Grant, now time.Time) bool {
+	for _, grant := range grants {
+		if grant.Expiration == nil || grant.Expiration.After(now) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/client"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/authz"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+// Test mnemonic (DO NOT use in production)
+const (
+	testMnemonic = "test test test test test test test test test test test junk"
+	testPassword = ""
+)
+
+func newTestAccounts(t *testing.T) (*account.Account, *account.Account) {
+	t.Helper()
+
+	c, err := client.NewClient(context.Background(), false)
+	require.NoError(t, err)
+
+	owner, err := account.NewAccount(c, "owner", testMnemonic, testPassword)
+	require.NoError(t, err)
+
+	operator, err := account.NewAccount(c, "operator", account.TestMnemonic, testPassword)
+	require.NoError(t, err)
+
+	return owner, operator
+}
+
+func TestBuildGrantOperatorMsgs(t *testing.T) {
+	owner, operator := newTestAccounts(t)
+
+	authzMsg, err := authz.NewAuthzMsg(*owner)
+	require.NoError(t, err)
+
+	t.Run("Grant every operator message type", func(t *testing.T) {
+		expiration := time.Now().Add(24 * time.Hour)
+
+		msgs, err := authzMsg.BuildGrantOperatorMsgs(operator.GetCosmosAddress().String(), expiration)
+		require.NoError(t, err)
+		require.Len(t, msgs, len(authz.OperatorMsgTypeURLs))
+
+		for i, msg := range msgs {
+			grant, ok := msg.(*authztypes.MsgGrant)
+			require.True(t, ok, "Message should be a MsgGrant")
+
+			assert.Equal(t, owner.GetCosmosAddress().String(), grant.Granter)
+			assert.Equal(t, operator.GetCosmosAddress().String(), grant.Grantee)
+			require.NotNil(t, grant.Grant.Expiration)
+			assert.True(t, grant.Grant.Expiration.Equal(expiration))
+
+			authorization, err := grant.GetAuthorization()
+			require.NoError(t, err)
+			assert.Equal(t, authz.OperatorMsgTypeURLs[i], authorization.MsgTypeURL())
+		}
+	})
+
+	t.Run("Reject expiration in the past", func(t *testing.T) {
+		_, err := authzMsg.BuildGrantOperatorMsgs(operator.GetCosmosAddress().String(), time.Now().Add(-time.Hour))
+		assert.Error(t, err)
+	})
+
+	t.Run("Reject invalid grantee", func(t *testing.T) {
+		_, err := authzMsg.BuildGrantOperatorMsgs("not-an-address", time.Now().Add(time.Hour))
+		assert.Error(t, err)
+	})
+
+	t.Run("Revoke every operator message type", func(t *testing.T) {
+		msgs, err := authzMsg.BuildRevokeOperatorMsgs(operator.GetCosmosAddress().String())
+		require.NoError(t, err)
+		require.Len(t, msgs, len(authz.OperatorMsgTypeURLs))
+
+		for i, msg := range msgs {
+			revoke, ok := msg.(*authztypes.MsgRevoke)
+			require.True(t, ok, "Message should be a MsgRevoke")
+			assert.Equal(t, authz.OperatorMsgTypeURLs[i], revoke.MsgTypeUrl)
+		}
+	})
+}
+
+func TestBuildExecMsg(t *testing.T) {
+	owner, operator := newTestAccounts(t)
+
+	accountMsg, err := account.NewAccountMsg(operator)
+	require.NoError(t, err)
+
+	action := &nftmngrtypes.MsgPerformActionByAdmin{
+		Creator:       owner.GetCosmosAddress().String(),
+		NftSchemaCode: "sixnetwork.lbbv01",
+		TokenId:       "1",
+		Action:        "freeze_cert",
+	}
+
+	execMsg, err := accountMsg.WithExecAs(owner.GetCosmosAddress()).BuildExecMsg(action)
+	require.NoError(t, err)
+
+	assert.Equal(t, operator.GetCosmosAddress().String(), execMsg.Grantee)
+	require.Len(t, execMsg.Msgs, 1)
+	assert.Equal(t, sdk.MsgTypeURL(action), execMsg.Msgs[0].TypeUrl)
+
+	_, err = accountMsg.BuildExecMsg()
+	assert.Error(t, err, "Should reject empty message list")
+}
+
+func TestMetadataMsgWithExecAs(t *testing.T) {
+	owner, operator := newTestAccounts(t)
+
+	metaMsg, err := metadata.NewMetadataMsg(*operator, "sixnetwork.lbbv01")
+	require.NoError(t, err)
+
+	delegated, err := metaMsg.WithExecAs(owner.GetCosmosAddress().String())
+	require.NoError(t, err)
+
+	msg, err := delegated.BuildMintMetadataMsg("1")
+	require.NoError(t, err)
+	assert.Equal(t, owner.GetCosmosAddress().String(), msg.Creator, "Creator should be the schema owner")
+
+	msg, err = metaMsg.BuildMintMetadataMsg("1")
+	require.NoError(t, err)
+	assert.Equal(t, operator.GetCosmosAddress().String(), msg.Creator, "Original MetadataMsg should be unchanged")
+
+	deployMsg, err := delegated.BuildDeployMsg()
+	require.NoError(t, err)
+	assert.Equal(t, operator.GetCosmosAddress().String(), deployMsg.Creator, "Schema should be created by the signer")
+	assert.NotContains(t, authz.OperatorMsgTypeURLs, sdk.MsgTypeURL(deployMsg))
+
+	_, err = metaMsg.WithExecAs("invalid")
+	assert.Error(t, err)
+}
@@ -0,0 +1,211 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+)
+
+type AuthzMsg struct {
+	Authz
+	accountMsg *account.AccountMsg
+}
+
+type AuthzMsgI interface {
+	AuthzI
+	BuildGrantMsg(grantee, msgTypeURL string, expiration time.Time) (*authztypes.MsgGrant, error)
+	BuildRevokeMsg(grantee, msgTypeURL string) (*authztypes.MsgRevoke, error)
+	BuildGrantOperatorMsgs(grantee string, expiration time.Time) ([]sdk.Msg, error)
+	BuildRevokeOperatorMsgs(grantee string) ([]sdk.Msg, error)
+	GrantOperator(grantee string, expiration time.Time) (*sdk.TxResponse, error)
+	GrantOperatorAndWait(grantee string, expiration time.Time) (*sdk.TxResponse, error)
+	RevokeOperator(grantee string) (*sdk.TxResponse, error)
+	RevokeOperatorAndWait(grantee string) (*sdk.TxResponse, error)
+	BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error)
+	WithGas(gas uint64) *AuthzMsg
+	WithGasAdjustment(gasAdjustment float64) *AuthzMsg
+	WithGasPrices(gasPrices string) *AuthzMsg
+	WithFees(fees string) *AuthzMsg
+	WithMemo(memo string) *AuthzMsg
+	WithTimeoutHeight(timeoutHeight uint64) *AuthzMsg
+}
+
+var _ AuthzMsgI = (*AuthzMsg)(nil)
+
+func NewAuthzMsg(acc account.Account) (*AuthzMsg, error) {
+	accountMsg, err := account.NewAccountMsg(&acc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthzMsg{
+		Authz: Authz{
+			account: acc,
+		},
+		accountMsg: accountMsg,
+	}, nil
+}
+
+// BuildGrantMsg builds a MsgGrant giving the grantee a GenericAuthorization for msgTypeURL
+// The account of this AuthzMsg is the granter
+func (a *AuthzMsg) BuildGrantMsg(grantee, msgTypeURL string, expiration time.Time) (*authztypes.MsgGrant, error) {
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grantee address %s: %w", grantee, err)
+	}
+
+	if msgTypeURL == "" {
+		return nil, fmt.Errorf("message type URL cannot be empty")
+	}
+
+	if !expiration.After(time.Now()) {
+		return nil, fmt.Errorf("expiration %s must be in the future", expiration.Format(time.RFC3339))
+	}
+
+	msg, err := authztypes.NewMsgGrant(
+		a.account.GetCosmosAddress(),
+		granteeAddr,
+		authztypes.NewGenericAuthorization(msgTypeURL),
+		&expiration,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build grant for %s: %w", msgTypeURL, err)
+	}
+
+	return msg, nil
+}
+
+// BuildRevokeMsg builds a MsgRevoke removing the grantee's authorization for msgTypeURL
+func (a *AuthzMsg) BuildRevokeMsg(grantee, msgTypeURL string) (*authztypes.MsgRevoke, error) {
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grantee address %s: %w", grantee, err)
+	}
+
+	if msgTypeURL == "" {
+		return nil, fmt.Errorf("message type URL cannot be empty")
+	}
+
+	msg := authztypes.NewMsgRevoke(a.account.GetCosmosAddress(), granteeAddr, msgTypeURL)
+	return &msg, nil
+}
+
+// BuildGrantOperatorMsgs builds the grants that let an operator key create metadata
+// and perform admin actions on behalf of the schema owner
+func (a *AuthzMsg) BuildGrantOperatorMsgs(grantee string, expiration time.Time) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(OperatorMsgTypeURLs))
+	for _, msgTypeURL := range OperatorMsgTypeURLs {
+		msg, err := a.BuildGrantMsg(grantee, msgTypeURL, expiration)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// BuildRevokeOperatorMsgs builds the revocations matching BuildGrantOperatorMsgs
+func (a *AuthzMsg) BuildRevokeOperatorMsgs(grantee string) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(OperatorMsgTypeURLs))
+	for _, msgTypeURL := range OperatorMsgTypeURLs {
+		msg, err := a.BuildRevokeMsg(grantee, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// GrantOperator grants an operator key the schema owner's metadata permissions until expiration
+func (a *AuthzMsg) GrantOperator(grantee string, expiration time.Time) (*sdk.TxResponse, error) {
+	msgs, err := a.BuildGrantOperatorMsgs(grantee, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.accountMsg.BroadcastTx(msgs...)
+}
+
+// GrantOperatorAndWait grants operator permissions and waits for the transaction to be confirmed
+func (a *AuthzMsg) GrantOperatorAndWait(grantee string, expiration time.Time) (*sdk.TxResponse, error) {
+	msgs, err := a.BuildGrantOperatorMsgs(grantee, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.accountMsg.BroadcastTxAndWait(msgs...)
+}
+
+// RevokeOperator revokes every operator permission previously granted to the grantee
+func (a *AuthzMsg) RevokeOperator(grantee string) (*sdk.TxResponse, error) {
+	msgs, err := a.BuildRevokeOperatorMsgs(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.accountMsg.BroadcastTx(msgs...)
+}
+
+// RevokeOperatorAndWait revokes operator permissions and waits for the transaction to be confirmed
+func (a *AuthzMsg) RevokeOperatorAndWait(grantee string) (*sdk.TxResponse, error) {
+	msgs, err := a.BuildRevokeOperatorMsgs(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.accountMsg.BroadcastTxAndWait(msgs...)
+}
+
+// BroadcastTx broadcasts one or more messages
+func (a *AuthzMsg) BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return a.accountMsg.BroadcastTx(msgs...)
+}
+
+// NOTE: THESE ARE UTILITIES METHOD ALLOW use to modify tx factory setting of the package.
+
+// WithGas returns a new AuthzMsg with the specified gas limit
+func (a *AuthzMsg) WithGas(gas uint64) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithGas(gas)
+	return &newAuthzMsg
+}
+
+// WithGasAdjustment returns a new AuthzMsg with the specified gas adjustment
+func (a *AuthzMsg) WithGasAdjustment(gasAdjustment float64) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithGasAdjustment(gasAdjustment)
+	return &newAuthzMsg
+}
+
+// WithGasPrices returns a new AuthzMsg with the specified gas prices
+func (a *AuthzMsg) WithGasPrices(gasPrices string) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithGasPrices(gasPrices)
+	return &newAuthzMsg
+}
+
+// WithFees returns a new AuthzMsg with the specified fees
+func (a *AuthzMsg) WithFees(fees string) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithFees(fees)
+	return &newAuthzMsg
+}
+
+// WithMemo returns a new AuthzMsg with the specified memo
+func (a *AuthzMsg) WithMemo(memo string) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithMemo(memo)
+	return &newAuthzMsg
+}
+
+// WithTimeoutHeight returns a new AuthzMsg with the specified timeout height
+func (a *AuthzMsg) WithTimeoutHeight(timeoutHeight uint64) *AuthzMsg {
+	newAuthzMsg := *a
+	newAuthzMsg.accountMsg = newAuthzMsg.accountMsg.WithTimeoutHeight(timeoutHeight)
+	return &newAuthzMsg
+}
@@ -5,6 +5,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
 )
 
 const (
@@ -20,6 +21,8 @@ type BalanceI interface {
 	GetBalance() (sdk.Coins, error)
 	GetCosmosBalance() (sdk.Coin, error)
 	GetEVMBalance() (sdk.Coin, error)
+	GetAddressBalance(addr, denom string) (sdk.Coin, error)
+	Snapshot() (Snapshot, error)
 	GetAccount() account.Account
 }
 
@@ -74,8 +77,7 @@ func (b *Balance) GetEVMBalance() (sdk.Coin, error) {
 	clientCtx := b.account.GetClient().GetClientCTX()
 	queryClient := banktypes.NewQueryClient(clientCtx)
 
-	addr := b.account.GetEVMAddress().Bytes()
-	bech32AccAddress := sdk.AccAddress(addr)
+	bech32AccAddress := address.EVMToAccAddress(b.account.GetEVMAddress())
 
 	res, err := queryClient.Balance(goCtx, &banktypes.QueryBalanceRequest{
 		Address: bech32AccAddress.String(),
@@ -104,3 +106,26 @@ func (b *Balance) GetBalanceByDenom(denom string) (sdk.Coin, error) {
 
 	return *res.Balance, nil
 }
+
+// GetAddressBalance retrieves the balance of any address for a specific denomination
+// The address may be given as a 6x bech32 or a 0x hex address
+func (b *Balance) GetAddressBalance(addr, denom string) (sdk.Coin, error) {
+	goCtx := b.account.GetClient().GetContext()
+	clientCtx := b.account.GetClient().GetClientCTX()
+	queryClient := banktypes.NewQueryClient(clientCtx)
+
+	bech32Address, err := address.ToBech32(addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	res, err := queryClient.Balance(goCtx, &banktypes.QueryBalanceRequest{
+		Address: bech32Address,
+		Denom:   denom,
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return *res.Balance, nil
+}
@@ -106,7 +106,7 @@ func TestBuildSendMsg(t *testing.T) {
 		require.NoError(t, err)
 
 		amount := sdk.NewCoins(sdk.NewInt64Coin("usix", 1000000))
-		destAddr := "six1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyk9rgcg"
+		destAddr := "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
 
 		msg, err := balMsg.BuildSendMsg(destAddr, amount)
 		require.NoError(t, err, "Should build message without error")
@@ -131,8 +131,8 @@ func TestBuildSendMsg(t *testing.T) {
 		require.NoError(t, err)
 
 		amount := sdk.NewCoins(sdk.NewInt64Coin("usix", 1000000))
-		dest1 := "six1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyk9rgcg"
-		dest2 := "six1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+		dest1 := "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
+		dest2 := "0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"
 
 		msg1, err := balMsg.BuildSendMsg(dest1, amount)
 		require.NoError(t, err)
@@ -147,6 +147,31 @@ func TestBuildSendMsg(t *testing.T) {
 	})
 }
 
+func TestBuildSendMsgAddressFormats(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewClient(ctx, false)
+	require.NoError(t, err)
+
+	acc, err := account.NewAccount(c, "testaccount", testMnemonic, testPassword)
+	require.NoError(t, err)
+
+	balMsg, err := balance.NewBalanceMsg(*acc)
+	require.NoError(t, err)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usix", 1000000))
+
+	t.Run("Hex destination is converted to bech32", func(t *testing.T) {
+		msg, err := balMsg.BuildSendMsg("0x0102030405060708090a0b0c0d0e0f1011121314", amount)
+		require.NoError(t, err)
+		assert.Equal(t, "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw", msg.ToAddress)
+	})
+
+	t.Run("Invalid destination is rejected", func(t *testing.T) {
+		_, err := balMsg.BuildSendMsg("six1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd", amount)
+		assert.Error(t, err, "Should reject address with the wrong prefix")
+	})
+}
+
 func TestBalanceMsgConfiguration(t *testing.T) {
 	t.Run("Configure transaction with fluent API", func(t *testing.T) {
 		ctx := context.Background()
@@ -296,7 +321,7 @@ func BenchmarkBuildSendMsg(b *testing.B) {
 	balMsg, _ := balance.NewBalanceMsg(*acc)
 
 	amount := sdk.NewCoins(sdk.NewInt64Coin("usix", 1000000))
-	destAddr := "six1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyk9rgcg"
+	destAddr := "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
 
 	b.ResetTimer()
 	for b.Loop() {
@@ -318,3 +343,33 @@ func BenchmarkWithConfiguration(b *testing.B) {
 			WithMemo("benchmark")
 	}
 }
+
+func TestSnapshotTotal(t *testing.T) {
+	snapshot := balance.Snapshot{
+		CosmosAddress: "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw",
+		EVMAddress:    "0x8a28fb81A084Ac7A276800957a19a6054BF86E4D",
+		Cosmos:        sdk.NewInt64Coin(balance.BaseDenom, 1_500_000),
+		EVM:           sdk.NewInt64Coin(balance.EVMDenom, 500_000_000_000_000_000),
+	}
+
+	total, err := snapshot.Total()
+	require.NoError(t, err)
+	assert.Equal(t, balance.EVMDenom, total.Denom)
+	assert.Equal(t, "2000000000000000000", total.Amount.String())
+	assert.Contains(t, snapshot.String(), "total: 2 SIX")
+
+	t.Run("Empty snapshot", func(t *testing.T) {
+		total, err := balance.Snapshot{}.Total()
+		require.NoError(t, err)
+		assert.True(t, total.IsZero())
+	})
+
+	t.Run("Unconvertible denom", func(t *testing.T) {
+		snapshot := snapshot
+		snapshot.Cosmos = sdk.NewInt64Coin("uatom", 1_000_000)
+
+		_, err := snapshot.Total()
+		assert.ErrorIs(t, err, balance.ErrUnsupportedDenom)
+		assert.Contains(t, snapshot.String(), "total: unknown")
+	})
+}
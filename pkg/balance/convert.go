@@ -0,0 +1,134 @@
+package balance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DisplayDenom is the human readable unit, 1 SIX = 10^6 usix = 10^18 asix
+	DisplayDenom = "six"
+
+	// BaseDenomExponent is the number of decimals of usix relative to SIX
+	BaseDenomExponent = 6
+	// EVMDenomExponent is the number of decimals of asix relative to SIX
+	EVMDenomExponent = 18
+
+	// MicroToAttoFactor is the number of asix in one usix
+	MicroToAttoFactor = int64(1_000_000_000_000)
+)
+
+var (
+	ErrUnsupportedDenom  = errors.New("unsupported denom")
+	ErrInexactConversion = errors.New("amount cannot be converted without losing precision")
+)
+
+// DenomExponent returns the number of decimals of a SIX denom relative to one SIX
+func DenomExponent(denom string) (int64, error) {
+	switch denom {
+	case BaseDenom:
+		return BaseDenomExponent, nil
+	case EVMDenom:
+		return EVMDenomExponent, nil
+	case DisplayDenom:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedDenom, denom)
+	}
+}
+
+// ConvertCoin converts a usix or asix coin to the target denom without rounding
+// Converting asix to usix fails with ErrInexactConversion when the amount is not a whole number of usix
+func ConvertCoin(coin sdk.Coin, targetDenom string) (sdk.Coin, error) {
+	if coin.Denom == targetDenom {
+		return coin, nil
+	}
+
+	switch {
+	case coin.Denom == BaseDenom && targetDenom == EVMDenom:
+		return sdk.NewCoin(EVMDenom, coin.Amount.MulRaw(MicroToAttoFactor)), nil
+	case coin.Denom == EVMDenom && targetDenom == BaseDenom:
+		if !coin.Amount.ModRaw(MicroToAttoFactor).IsZero() {
+			return sdk.Coin{}, fmt.Errorf("%w: %s is not a multiple of %d%s", ErrInexactConversion, coin, MicroToAttoFactor, EVMDenom)
+		}
+		return sdk.NewCoin(BaseDenom, coin.Amount.QuoRaw(MicroToAttoFactor)), nil
+	default:
+		return sdk.Coin{}, fmt.Errorf("%w: cannot convert %s to %s", ErrUnsupportedDenom, coin.Denom, targetDenom)
+	}
+}
+
+// ToSIX returns the amount of a usix or asix coin in whole SIX units
+func ToSIX(coin sdk.Coin) (sdkmath.LegacyDec, error) {
+	exponent, err := DenomExponent(coin.Denom)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	if exponent == 0 {
+		return sdkmath.LegacyNewDecFromInt(coin.Amount), nil
+	}
+
+	return sdkmath.LegacyNewDecFromIntWithPrec(coin.Amount, exponent), nil
+}
+
+// FromSIX parses a human readable SIX amount such as "1.5" into a coin of the given denom
+// The amount must be representable exactly in the denom, "0.0000001" SIX fails for usix
+func FromSIX(amount string, denom string) (sdk.Coin, error) {
+	exponent, err := DenomExponent(denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount = strings.TrimSpace(amount)
+	if amount == "" {
+		return sdk.Coin{}, errors.New("amount cannot be empty")
+	}
+
+	if decimals := decimalPlaces(amount); decimals > exponent {
+		return sdk.Coin{}, fmt.Errorf("%w: %s SIX has %d decimals, %s supports %d", ErrInexactConversion, amount, decimals, denom, exponent)
+	}
+
+	dec, err := sdkmath.LegacyNewDecFromStr(amount)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid SIX amount %q: %w", amount, err)
+	}
+
+	if dec.IsNegative() {
+		return sdk.Coin{}, fmt.Errorf("SIX amount cannot be negative: %s", amount)
+	}
+
+	scaled := dec.Mul(sdkmath.LegacyNewDecFromInt(sdkmath.NewIntWithDecimal(1, int(exponent))))
+	if !scaled.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%w: %s SIX in %s", ErrInexactConversion, amount, denom)
+	}
+
+	return sdk.NewCoin(denom, scaled.TruncateInt()), nil
+}
+
+// FormatSIX renders a usix or asix coin as a human readable SIX amount without trailing zeros
+func FormatSIX(coin sdk.Coin) (string, error) {
+	dec, err := ToSIX(coin)
+	if err != nil {
+		return "", err
+	}
+
+	str := dec.String()
+	if strings.Contains(str, ".") {
+		str = strings.TrimRight(strings.TrimRight(str, "0"), ".")
+	}
+
+	return str + " " + strings.ToUpper(DisplayDenom), nil
+}
+
+// decimalPlaces counts the significant decimals of a decimal string, ignoring trailing zeros
+func decimalPlaces(amount string) int64 {
+	idx := strings.IndexByte(amount, '.')
+	if idx < 0 {
+		return 0
+	}
+	return int64(len(strings.TrimRight(amount[idx+1:], "0")))
+}
@@ -0,0 +1,137 @@
+package balance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/client"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/balance"
+)
+
+func TestConvertCoin(t *testing.T) {
+	t.Run("usix to asix", func(t *testing.T) {
+		coin, err := balance.ConvertCoin(sdk.NewInt64Coin(balance.BaseDenom, 1_500_000), balance.EVMDenom)
+		require.NoError(t, err)
+
+		expected, ok := sdkmath.NewIntFromString("1500000000000000000")
+		require.True(t, ok)
+		assert.Equal(t, sdk.NewCoin(balance.EVMDenom, expected), coin)
+	})
+
+	t.Run("asix to usix", func(t *testing.T) {
+		coin, err := balance.ConvertCoin(sdk.NewInt64Coin(balance.EVMDenom, 3_000_000_000_000), balance.BaseDenom)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewInt64Coin(balance.BaseDenom, 3), coin)
+	})
+
+	t.Run("asix with a fraction of usix is rejected", func(t *testing.T) {
+		_, err := balance.ConvertCoin(sdk.NewInt64Coin(balance.EVMDenom, 1_000_000_000_001), balance.BaseDenom)
+		assert.True(t, errors.Is(err, balance.ErrInexactConversion))
+	})
+
+	t.Run("Unsupported denom", func(t *testing.T) {
+		_, err := balance.ConvertCoin(sdk.NewInt64Coin("uatom", 1), balance.EVMDenom)
+		assert.True(t, errors.Is(err, balance.ErrUnsupportedDenom))
+	})
+}
+
+func TestSIXUnits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   string
+		denom    string
+		expected string
+	}{
+		{"Whole SIX in usix", "2", balance.BaseDenom, "2000000usix"},
+		{"Fractional SIX in usix", "1.5", balance.BaseDenom, "1500000usix"},
+		{"Trailing zeros are ignored", "0.1000000000", balance.BaseDenom, "100000usix"},
+		{"Smallest usix", "0.000001", balance.BaseDenom, "1usix"},
+		{"Smallest asix", "0.000000000000000001", balance.EVMDenom, "1asix"},
+		{"Large asix amount", "123456789.123456789123456789", balance.EVMDenom, "123456789123456789123456789asix"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			coin, err := balance.FromSIX(tc.amount, tc.denom)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, coin.String())
+
+			// Round trip back to SIX without loss
+			six, err := balance.ToSIX(coin)
+			require.NoError(t, err)
+			expected, err := sdkmath.LegacyNewDecFromStr(tc.amount)
+			require.NoError(t, err)
+			assert.True(t, expected.Equal(six), "expected %s, got %s", expected, six)
+		})
+	}
+
+	t.Run("Too many decimals for usix", func(t *testing.T) {
+		_, err := balance.FromSIX("0.0000001", balance.BaseDenom)
+		assert.True(t, errors.Is(err, balance.ErrInexactConversion))
+	})
+
+	t.Run("Negative amount", func(t *testing.T) {
+		_, err := balance.FromSIX("-1", balance.BaseDenom)
+		assert.Error(t, err)
+	})
+
+	t.Run("Format SIX", func(t *testing.T) {
+		str, err := balance.FormatSIX(sdk.NewInt64Coin(balance.BaseDenom, 1_250_000))
+		require.NoError(t, err)
+		assert.Equal(t, "1.25 SIX", str)
+
+		str, err = balance.FormatSIX(sdk.NewInt64Coin(balance.EVMDenom, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "0 SIX", str)
+	})
+}
+
+func TestBuildConvertMsgs(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewClient(ctx, false)
+	require.NoError(t, err)
+
+	acc, err := account.NewAccount(c, "testaccount", testMnemonic, testPassword)
+	require.NoError(t, err)
+
+	balMsg, err := balance.NewBalanceMsg(*acc)
+	require.NoError(t, err)
+
+	t.Run("Convert to EVM defaults to own EVM address", func(t *testing.T) {
+		msg, err := balMsg.BuildConvertToEVMMsg(sdk.NewInt64Coin(balance.BaseDenom, 1000), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, acc.GetCosmosAddress().String(), msg.Creator)
+		assert.Equal(t, acc.GetEVMAddress().Hex(), msg.Receiver)
+		assert.Equal(t, sdk.NewInt64Coin(balance.BaseDenom, 1000), msg.Amount)
+	})
+
+	t.Run("Convert to EVM accepts asix amounts", func(t *testing.T) {
+		msg, err := balMsg.BuildConvertToEVMMsg(sdk.NewInt64Coin(balance.EVMDenom, 2_000_000_000_000), "")
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewInt64Coin(balance.BaseDenom, 2), msg.Amount)
+	})
+
+	t.Run("Convert to Cosmos uses asix and a bech32 receiver", func(t *testing.T) {
+		msg, err := balMsg.BuildConvertToCosmosMsg(sdk.NewInt64Coin(balance.BaseDenom, 5), acc.GetEVMAddress().Hex())
+		require.NoError(t, err)
+
+		assert.Equal(t, sdk.NewInt64Coin(balance.EVMDenom, 5_000_000_000_000), msg.Amount)
+		assert.Equal(t, sdk.AccAddress(acc.GetEVMAddress().Bytes()).String(), msg.Receiver)
+	})
+
+	t.Run("Reject zero and inexact amounts", func(t *testing.T) {
+		_, err := balMsg.BuildConvertToEVMMsg(sdk.NewInt64Coin(balance.BaseDenom, 0), "")
+		assert.Error(t, err)
+
+		_, err = balMsg.BuildConvertToCosmosMsg(sdk.NewInt64Coin(balance.EVMDenom, 1), "")
+		assert.True(t, errors.Is(err, balance.ErrInexactConversion))
+	})
+}
@@ -0,0 +1,172 @@
+package balance
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
+)
+
+// DistributionEntry is one valid row of a distribution CSV
+type DistributionEntry struct {
+	// Line is the 1-based line number in the CSV
+	Line int
+	// Address is the recipient normalized to its 6x bech32 form
+	Address string
+	Amount  sdk.Coins
+}
+
+// DistributionDuplicate is a recipient listed on more than one line
+type DistributionDuplicate struct {
+	Address string
+	Lines   []int
+}
+
+// Distribution is a validated list of recipients loaded from a CSV
+type Distribution struct {
+	Entries    []DistributionEntry
+	Duplicates []DistributionDuplicate
+}
+
+// LoadDistributionCSV reads and validates a distribution CSV file, see ParseDistributionCSV
+func LoadDistributionCSV(path string) (*Distribution, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open distribution file: %w", err)
+	}
+	defer file.Close()
+
+	return ParseDistributionCSV(file)
+}
+
+// ParseDistributionCSV reads rows of "address,amount" such as "6x1...,1000000usix"
+// Addresses may be 6x bech32 or 0x hex. Amounts with several denoms must be quoted, e.g. "1usix,5asix".
+// A leading "address,amount" header, blank lines and lines starting with # are skipped.
+// Every invalid row is reported in the returned error; duplicates are collected in Distribution.Duplicates
+func ParseDistributionCSV(r io.Reader) (*Distribution, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	distribution := &Distribution{}
+	lines := make(map[string][]int)
+
+	var errs []error
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read distribution: %w", err)
+			}
+			errs = append(errs, err)
+			continue
+		}
+
+		if first && isDistributionHeader(record) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		bech32Address, err := address.ToBech32(record[0])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+
+		amount, err := sdk.ParseCoinsNormalized(strings.TrimSpace(record[1]))
+		if err == nil {
+			err = validateSendAmount(amount)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: invalid amount %q: %w", line, record[1], err))
+			continue
+		}
+
+		lines[bech32Address] = append(lines[bech32Address], line)
+		distribution.Entries = append(distribution.Entries, DistributionEntry{
+			Line:    line,
+			Address: bech32Address,
+			Amount:  amount,
+		})
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if len(distribution.Entries) == 0 {
+		return nil, fmt.Errorf("distribution has no recipients")
+	}
+
+	for addr, addrLines := range lines {
+		if len(addrLines) > 1 {
+			distribution.Duplicates = append(distribution.Duplicates, DistributionDuplicate{
+				Address: addr,
+				Lines:   addrLines,
+			})
+		}
+	}
+
+	sort.Slice(distribution.Duplicates, func(i, j int) bool {
+		return distribution.Duplicates[i].Lines[0] < distribution.Duplicates[j].Lines[0]
+	})
+
+	return distribution, nil
+}
+
+// Recipients returns the distribution as input for MultiSend
+// It fails with ErrDuplicateRecipient when any recipient is listed more than once, use Merged to sum them instead
+func (d *Distribution) Recipients() (map[string]sdk.Coins, error) {
+	if len(d.Duplicates) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrDuplicateRecipient, d.duplicateSummary())
+	}
+
+	return d.Merged(), nil
+}
+
+// Merged returns the distribution as input for MultiSend, summing the amounts of duplicate recipients
+func (d *Distribution) Merged() map[string]sdk.Coins {
+	recipients := make(map[string]sdk.Coins, len(d.Entries))
+	for _, entry := range d.Entries {
+		recipients[entry.Address] = recipients[entry.Address].Add(entry.Amount...)
+	}
+
+	return recipients
+}
+
+// Total returns the sum of every amount in the distribution
+func (d *Distribution) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, entry := range d.Entries {
+		total = total.Add(entry.Amount...)
+	}
+
+	return total
+}
+
+func (d *Distribution) duplicateSummary() string {
+	parts := make([]string, 0, len(d.Duplicates))
+	for _, duplicate := range d.Duplicates {
+		parts = append(parts, fmt.Sprintf("%s on lines %v", duplicate.Address, duplicate.Lines))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func isDistributionHeader(record []string) bool {
+	return strings.EqualFold(strings.TrimSpace(record[0]), "address")
+}
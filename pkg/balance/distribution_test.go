@@ -0,0 +1,242 @@
+package balance_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/client"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/balance"
+)
+
+const (
+	testRecipientBech32 = "6x1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5svmtjw"
+	testRecipientHex    = "0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"
+)
+
+func TestParseDistributionCSV(t *testing.T) {
+	t.Run("Valid distribution with header and comments", func(t *testing.T) {
+		input := strings.Join([]string{
+			"address,amount",
+			"# cohort 1",
+			testRecipientBech32 + ",1000000usix",
+			"",
+			testRecipientHex + `, "1usix,5asix"`,
+		}, "\n")
+
+		dist, err := balance.ParseDistributionCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, dist.Entries, 2)
+		assert.Empty(t, dist.Duplicates)
+
+		assert.Equal(t, 3, dist.Entries[0].Line)
+		assert.Equal(t, testRecipientBech32, dist.Entries[0].Address)
+		assert.Equal(t, 5, dist.Entries[1].Line)
+		assert.True(t, strings.HasPrefix(dist.Entries[1].Address, "6x1"), "Hex address should be normalized to bech32")
+		assert.Equal(t, "5asix,1000001usix", dist.Total().String())
+
+		recipients, err := dist.Recipients()
+		require.NoError(t, err)
+		assert.Len(t, recipients, 2)
+	})
+
+	t.Run("Duplicates are reported", func(t *testing.T) {
+		hexOfBech32 := "0x0102030405060708090a0b0c0d0e0f1011121314"
+		input := fmt.Sprintf("%s,1usix\n%s,2usix\n%s,3usix\n", testRecipientBech32, testRecipientHex, hexOfBech32)
+
+		dist, err := balance.ParseDistributionCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, dist.Duplicates, 1)
+		assert.Equal(t, testRecipientBech32, dist.Duplicates[0].Address)
+		assert.Equal(t, []int{1, 3}, dist.Duplicates[0].Lines)
+
+		_, err = dist.Recipients()
+		assert.True(t, errors.Is(err, balance.ErrDuplicateRecipient))
+
+		merged := dist.Merged()
+		assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, 4)), merged[testRecipientBech32])
+	})
+
+	t.Run("Every invalid row is reported", func(t *testing.T) {
+		input := strings.Join([]string{
+			"six1invalid,1usix",
+			testRecipientBech32 + ",abc",
+			testRecipientHex + ",0usix",
+			testRecipientHex,
+		}, "\n")
+
+		_, err := balance.ParseDistributionCSV(strings.NewReader(input))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 1")
+		assert.Contains(t, err.Error(), "line 2")
+		assert.Contains(t, err.Error(), "line 3")
+		assert.Contains(t, err.Error(), "line 4")
+	})
+
+	t.Run("Empty distribution", func(t *testing.T) {
+		_, err := balance.ParseDistributionCSV(strings.NewReader("address,amount\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Load from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "distribution.csv")
+		require.NoError(t, os.WriteFile(path, []byte(testRecipientBech32+",1usix\n"), 0o600))
+
+		dist, err := balance.LoadDistributionCSV(path)
+		require.NoError(t, err)
+		assert.Len(t, dist.Entries, 1)
+	})
+}
+
+func TestBuildMultiSendMsgs(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewClient(ctx, false)
+	require.NoError(t, err)
+
+	acc, err := account.NewAccount(c, "testaccount", testMnemonic, testPassword)
+	require.NoError(t, err)
+
+	balMsg, err := balance.NewBalanceMsg(*acc)
+	require.NoError(t, err)
+
+	recipients := make(map[string]sdk.Coins)
+	for i := 1; i <= 5; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("recipient-%010d", i))).String()
+		recipients[addr] = sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, int64(i)))
+	}
+
+	t.Run("Chunks are sorted and balanced", func(t *testing.T) {
+		msgs, err := balMsg.WithMultiSendChunkSize(2).BuildMultiSendMsgs(recipients)
+		require.NoError(t, err)
+		require.Len(t, msgs, 3)
+
+		var previous string
+		for _, msg := range msgs {
+			require.Len(t, msg.Inputs, 1)
+			assert.Equal(t, acc.GetCosmosAddress().String(), msg.Inputs[0].Address)
+
+			total := sdk.NewCoins()
+			for _, output := range msg.Outputs {
+				assert.Greater(t, output.Address, previous)
+				previous = output.Address
+				total = total.Add(output.Coins...)
+			}
+			assert.Equal(t, total, msg.Inputs[0].Coins)
+		}
+		assert.Len(t, msgs[2].Outputs, 1)
+	})
+
+	t.Run("Default chunk size", func(t *testing.T) {
+		msgs, err := balMsg.BuildMultiSendMsgs(recipients)
+		require.NoError(t, err)
+		assert.Len(t, msgs, 1)
+	})
+
+	t.Run("Same address in both formats", func(t *testing.T) {
+		_, err := balMsg.BuildMultiSendMsgs(map[string]sdk.Coins{
+			testRecipientBech32:                          sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, 1)),
+			"0x0102030405060708090a0b0c0d0e0f1011121314": sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, 1)),
+		})
+		assert.True(t, errors.Is(err, balance.ErrDuplicateRecipient))
+	})
+
+	t.Run("Reject invalid input", func(t *testing.T) {
+		_, err := balMsg.BuildMultiSendMsgs(nil)
+		assert.Error(t, err)
+
+		_, err = balMsg.BuildMultiSendMsgs(map[string]sdk.Coins{"invalid": sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, 1))})
+		assert.Error(t, err)
+
+		_, err = balMsg.BuildMultiSendMsgs(map[string]sdk.Coins{testRecipientBech32: sdk.NewCoins()})
+		assert.Error(t, err)
+	})
+}
+
+// fakeEstimator charges a fixed gas per transaction plus gas per output, counting the simulations
+type fakeEstimator struct {
+	base, perOutput uint64
+	calls           int
+}
+
+func (f *fakeEstimator) EstimateGas(msgs ...sdk.Msg) (uint64, error) {
+	f.calls++
+	return f.base + f.perOutput*uint64(len(msgs[0].(*banktypes.MsgMultiSend).Outputs)), nil
+}
+
+func TestFitMultiSendMsgs(t *testing.T) {
+	var _ balance.GasEstimator = (*account.AccountMsg)(nil)
+
+	ctx := context.Background()
+	c, err := client.NewClient(ctx, false)
+	require.NoError(t, err)
+
+	acc, err := account.NewAccount(c, "testaccount", testMnemonic, testPassword)
+	require.NoError(t, err)
+
+	balMsg, err := balance.NewBalanceMsg(*acc)
+	require.NoError(t, err)
+
+	recipients := make(map[string]sdk.Coins)
+	for i := 1; i <= 250; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("recipient-%010d", i))).String()
+		recipients[addr] = sdk.NewCoins(sdk.NewInt64Coin(balance.BaseDenom, int64(i)))
+	}
+
+	msgs, err := balMsg.BuildMultiSendMsgs(recipients)
+	require.NoError(t, err)
+	require.Len(t, msgs, 3)
+
+	t.Run("Chunks over the limit are split", func(t *testing.T) {
+		estimator := &fakeEstimator{base: 50_000, perOutput: 30_000}
+
+		fitted, err := balance.FitMultiSendMsgs(estimator, msgs, account.GasLimit)
+		require.NoError(t, err)
+		require.Greater(t, len(fitted), len(msgs))
+
+		var outputs []banktypes.Output
+		for _, msg := range fitted {
+			gas, err := estimator.EstimateGas(msg)
+			require.NoError(t, err)
+			assert.LessOrEqual(t, gas, account.GasLimit)
+
+			total := sdk.NewCoins()
+			for _, output := range msg.Outputs {
+				total = total.Add(output.Coins...)
+			}
+			assert.Equal(t, total, msg.Inputs[0].Coins)
+			outputs = append(outputs, msg.Outputs...)
+		}
+
+		var want []banktypes.Output
+		for _, msg := range msgs {
+			want = append(want, msg.Outputs...)
+		}
+		assert.Equal(t, want, outputs, "Outputs should keep their order")
+	})
+
+	t.Run("Chunks within the limit are kept", func(t *testing.T) {
+		estimator := &fakeEstimator{base: 50_000, perOutput: 1_000}
+
+		fitted, err := balance.FitMultiSendMsgs(estimator, msgs, account.GasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, msgs, fitted)
+		assert.Equal(t, len(msgs), estimator.calls)
+	})
+
+	t.Run("Single output over the limit", func(t *testing.T) {
+		estimator := &fakeEstimator{base: 50_000, perOutput: account.GasLimit}
+
+		_, err := balance.FitMultiSendMsgs(estimator, msgs, account.GasLimit)
+		assert.ErrorContains(t, err, "more than the gas limit")
+	})
+}
@@ -0,0 +1,176 @@
+package balance
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
+)
+
+// DefaultMultiSendChunkSize is the largest number of recipients per MsgMultiSend transaction
+// MultiSend simulates every chunk and splits the ones that need more gas than the transaction gas limit
+const DefaultMultiSendChunkSize = 100
+
+var ErrDuplicateRecipient = errors.New("duplicate recipient")
+
+// GasEstimator simulates a transaction of msgs and returns the gas it needs, account.AccountMsg implements it
+type GasEstimator interface {
+	EstimateGas(msgs ...sdk.Msg) (uint64, error)
+}
+
+// BuildMultiSendMsgs builds bank MsgMultiSend messages paying every recipient from this account
+// Recipients may be 6x bech32 or 0x hex addresses and are sorted so the output is deterministic.
+// Each message holds at most the configured chunk size of recipients
+func (b *BalanceMsg) BuildMultiSendMsgs(recipients map[string]sdk.Coins) ([]*banktypes.MsgMultiSend, error) {
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("recipients cannot be empty")
+	}
+
+	// Normalize first so the same address given in both formats is caught
+	outputs := make([]banktypes.Output, 0, len(recipients))
+	seen := make(map[string]string, len(recipients))
+	for addr, amount := range recipients {
+		bech32Address, err := address.ToBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if other, ok := seen[bech32Address]; ok {
+			return nil, fmt.Errorf("%w: %s and %s are the same address", ErrDuplicateRecipient, other, addr)
+		}
+		seen[bech32Address] = addr
+
+		if err := validateSendAmount(amount); err != nil {
+			return nil, fmt.Errorf("invalid amount for %s: %w", addr, err)
+		}
+
+		outputs = append(outputs, banktypes.NewOutput(sdk.MustAccAddressFromBech32(bech32Address), amount))
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Address < outputs[j].Address
+	})
+
+	chunkSize := b.multiSendChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultMultiSendChunkSize
+	}
+
+	msgs := make([]*banktypes.MsgMultiSend, 0, (len(outputs)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(outputs); start += chunkSize {
+		end := min(start+chunkSize, len(outputs))
+		msgs = append(msgs, newMultiSendMsg(b.account.GetCosmosAddress(), outputs[start:end]))
+	}
+
+	return msgs, nil
+}
+
+// FitMultiSendMsgs splits every message that needs more than gasLimit into smaller ones, keeping the order of the outputs
+// Each message is simulated; one that does not fit is cut into chunks sized from its gas per output and simulated again
+func FitMultiSendMsgs(estimator GasEstimator, msgs []*banktypes.MsgMultiSend, gasLimit uint64) ([]*banktypes.MsgMultiSend, error) {
+	fitted := make([]*banktypes.MsgMultiSend, 0, len(msgs))
+
+	pending := append([]*banktypes.MsgMultiSend(nil), msgs...)
+	for len(pending) > 0 {
+		msg := pending[0]
+		pending = pending[1:]
+
+		gas, err := estimator.EstimateGas(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to simulate chunk with %d recipients: %w", len(msg.Outputs), err)
+		}
+		if gas <= gasLimit {
+			fitted = append(fitted, msg)
+			continue
+		}
+
+		if len(msg.Outputs) == 1 {
+			return nil, fmt.Errorf("sending to %s needs %d gas, more than the gas limit of %d", msg.Outputs[0].Address, gas, gasLimit)
+		}
+
+		// Size from the gas per output, always at least one output smaller so every retry makes progress
+		gasPerOutput := (gas + uint64(len(msg.Outputs)) - 1) / uint64(len(msg.Outputs))
+		chunkSize := int(min(gasLimit/gasPerOutput, uint64(len(msg.Outputs)-1)))
+		chunkSize = max(chunkSize, 1)
+
+		from := sdk.MustAccAddressFromBech32(msg.Inputs[0].Address)
+		split := make([]*banktypes.MsgMultiSend, 0, (len(msg.Outputs)+chunkSize-1)/chunkSize)
+		for start := 0; start < len(msg.Outputs); start += chunkSize {
+			end := min(start+chunkSize, len(msg.Outputs))
+			split = append(split, newMultiSendMsg(from, msg.Outputs[start:end]))
+		}
+		pending = append(split, pending...)
+	}
+
+	return fitted, nil
+}
+
+// MultiSend pays every recipient using one MsgMultiSend transaction per chunk
+// Chunks are simulated first and split until each fits in the transaction gas limit, see FitMultiSendMsgs.
+// Chunks are broadcast one after another and each is confirmed before the next is sent.
+// On failure the responses of the chunks already confirmed are returned with the error
+func (b *BalanceMsg) MultiSend(recipients map[string]sdk.Coins) ([]*sdk.TxResponse, error) {
+	msgs, err := b.BuildMultiSendMsgs(recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	// A factory that estimates gas per transaction has no limit of its own, bound chunks by the default one then
+	factory := b.accountMsg.GetFactory()
+	gasLimit := factory.Gas()
+	if factory.SimulateAndExecute() || gasLimit == 0 {
+		gasLimit = account.GasLimit
+	}
+
+	msgs, err = FitMultiSendMsgs(b.accountMsg, msgs, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*sdk.TxResponse, 0, len(msgs))
+	for i, msg := range msgs {
+		res, err := b.accountMsg.BroadcastTxAndWait(msg)
+		if err != nil {
+			return responses, fmt.Errorf("chunk %d/%d with %d recipients failed: %w", i+1, len(msgs), len(msg.Outputs), err)
+		}
+		responses = append(responses, res)
+	}
+
+	return responses, nil
+}
+
+// WithMultiSendChunkSize returns a new BalanceMsg sending at most size recipients per transaction
+func (b *BalanceMsg) WithMultiSendChunkSize(size int) *BalanceMsg {
+	newBalanceMsg := *b
+	newBalanceMsg.multiSendChunkSize = size
+	return &newBalanceMsg
+}
+
+func newMultiSendMsg(from sdk.AccAddress, outputs []banktypes.Output) *banktypes.MsgMultiSend {
+	total := sdk.NewCoins()
+	for _, output := range outputs {
+		total = total.Add(output.Coins...)
+	}
+
+	return &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(from, total)},
+		Outputs: outputs,
+	}
+}
+
+func validateSendAmount(amount sdk.Coins) error {
+	if amount.Empty() {
+		return fmt.Errorf("amount cannot be empty")
+	}
+
+	if err := amount.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
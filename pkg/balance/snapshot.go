@@ -0,0 +1,75 @@
+package balance
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// Snapshot is the SIX held by an account on both layers at the time of the query
+// The Cosmos and EVM keys of an account differ, so the two sides are distinct addresses
+type Snapshot struct {
+	CosmosAddress string
+	EVMAddress    string
+	// Cosmos is the usix held by the Cosmos address
+	Cosmos sdk.Coin
+	// EVM is the native asix held by the EVM address, as reported by the EVM RPC
+	EVM sdk.Coin
+}
+
+// Total returns the combined holdings of both layers in asix
+// It fails when a side is in a denom that cannot be converted to asix rather than leave it out of the total
+func (s Snapshot) Total() (sdk.Coin, error) {
+	total := sdk.NewCoin(EVMDenom, sdkmath.ZeroInt())
+
+	for _, coin := range []sdk.Coin{s.Cosmos, s.EVM} {
+		if coin.IsNil() {
+			continue
+		}
+
+		converted, err := ConvertCoin(coin, EVMDenom)
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to total snapshot: %w", err)
+		}
+		total = total.Add(converted)
+	}
+
+	return total, nil
+}
+
+func (s Snapshot) String() string {
+	cosmos, _ := FormatSIX(s.Cosmos)
+	evmAmount, _ := FormatSIX(s.EVM)
+
+	total := "unknown"
+	if coin, err := s.Total(); err == nil {
+		total, _ = FormatSIX(coin)
+	}
+
+	return fmt.Sprintf("cosmos %s: %s | evm %s: %s | total: %s", s.CosmosAddress, cosmos, s.EVMAddress, evmAmount, total)
+}
+
+// Snapshot reports the account's usix on the Cosmos layer and native asix on the EVM layer side by side
+func (b *Balance) Snapshot() (Snapshot, error) {
+	cosmosBalance, err := b.GetCosmosBalance()
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to query cosmos balance: %w", err)
+	}
+
+	evmAddress := b.account.GetEVMAddress()
+
+	nativeBalance, err := evm.NewEVMClient(b.account).NativeBalance(evmAddress, nil)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("failed to query evm balance: %w", err)
+	}
+
+	return Snapshot{
+		CosmosAddress: b.account.GetCosmosAddress().String(),
+		EVMAddress:    evmAddress.Hex(),
+		Cosmos:        cosmosBalance,
+		EVM:           sdk.NewCoin(EVMDenom, sdkmath.NewIntFromBigInt(nativeBalance)),
+	}, nil
+}
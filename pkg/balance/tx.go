@@ -1,15 +1,21 @@
 package balance
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	tokenmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/tokenmngr/types"
+
 	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
 )
 
 type BalanceMsg struct {
 	Balance
-	accountMsg *account.AccountMsg
+	accountMsg         *account.AccountMsg
+	multiSendChunkSize int
 }
 
 type BalanceMsgI interface {
@@ -17,6 +23,14 @@ type BalanceMsgI interface {
 	BuildSendMsg(dest string, amount sdk.Coins) (*banktypes.MsgSend, error)
 	SendBalance(dest string, amount sdk.Coins) (*sdk.TxResponse, error)
 	SendBalanceAndWait(dest string, amount sdk.Coins) (*sdk.TxResponse, error)
+	BuildConvertToEVMMsg(amount sdk.Coin, receiver string) (*tokenmngrtypes.MsgWrapToken, error)
+	BuildConvertToCosmosMsg(amount sdk.Coin, receiver string) (*tokenmngrtypes.MsgUnwrapToken, error)
+	ConvertToEVM(amount sdk.Coin, receiver string) (*sdk.TxResponse, error)
+	ConvertToEVMAndWait(amount sdk.Coin, receiver string) (*sdk.TxResponse, error)
+	ConvertToCosmos(amount sdk.Coin, receiver string) (*sdk.TxResponse, error)
+	ConvertToCosmosAndWait(amount sdk.Coin, receiver string) (*sdk.TxResponse, error)
+	BuildMultiSendMsgs(recipients map[string]sdk.Coins) ([]*banktypes.MsgMultiSend, error)
+	MultiSend(recipients map[string]sdk.Coins) ([]*sdk.TxResponse, error)
 	BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error)
 	WithGas(gas uint64) *BalanceMsg
 	WithGasAdjustment(gasAdjustment float64) *BalanceMsg
@@ -24,6 +38,7 @@ type BalanceMsgI interface {
 	WithFees(fees string) *BalanceMsg
 	WithMemo(memo string) *BalanceMsg
 	WithTimeoutHeight(timeoutHeight uint64) *BalanceMsg
+	WithMultiSendChunkSize(size int) *BalanceMsg
 }
 
 var _ BalanceMsgI = (*BalanceMsg)(nil)
@@ -43,10 +58,16 @@ func NewBalanceMsg(acc account.Account) (*BalanceMsg, error) {
 }
 
 // BuildSendMsg builds a MsgSend message without broadcasting
+// The destination may be given as a 6x bech32 or a 0x hex address
 func (b *BalanceMsg) BuildSendMsg(dest string, amount sdk.Coins) (*banktypes.MsgSend, error) {
+	toAddress, err := address.ToBech32(dest)
+	if err != nil {
+		return nil, err
+	}
+
 	msg := &banktypes.MsgSend{
 		FromAddress: b.account.GetCosmosAddress().String(),
-		ToAddress:   dest,
+		ToAddress:   toAddress,
 		Amount:      amount,
 	}
 	return msg, nil
@@ -72,6 +93,109 @@ func (b *BalanceMsg) SendBalanceAndWait(dest string, amount sdk.Coins) (*sdk.TxR
 	return b.accountMsg.BroadcastTxAndWait(sendMsg)
 }
 
+// BuildConvertToEVMMsg builds a tokenmngr MsgWrapToken converting usix into asix on the EVM layer
+// The amount may be given in usix or asix; asix must be a whole number of usix.
+// An empty receiver credits the EVM address of this account
+func (b *BalanceMsg) BuildConvertToEVMMsg(amount sdk.Coin, receiver string) (*tokenmngrtypes.MsgWrapToken, error) {
+	microAmount, err := ConvertCoin(amount, BaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !microAmount.IsPositive() {
+		return nil, fmt.Errorf("conversion amount must be positive: %s", amount)
+	}
+
+	if receiver == "" {
+		receiver = b.account.GetEVMAddress().Hex()
+	}
+
+	receiverHex, err := address.ToHex(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenmngrtypes.MsgWrapToken{
+		Creator:  b.account.GetCosmosAddress().String(),
+		Amount:   microAmount,
+		Receiver: receiverHex,
+	}, nil
+}
+
+// BuildConvertToCosmosMsg builds a tokenmngr MsgUnwrapToken converting asix back into usix
+// The asix is taken from this account's Cosmos address, so it must hold the asix being converted.
+// An empty receiver credits the Cosmos address of this account
+func (b *BalanceMsg) BuildConvertToCosmosMsg(amount sdk.Coin, receiver string) (*tokenmngrtypes.MsgUnwrapToken, error) {
+	attoAmount, err := ConvertCoin(amount, EVMDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The module only unwraps whole usix amounts
+	if _, err := ConvertCoin(attoAmount, BaseDenom); err != nil {
+		return nil, err
+	}
+
+	if !attoAmount.IsPositive() {
+		return nil, fmt.Errorf("conversion amount must be positive: %s", amount)
+	}
+
+	if receiver == "" {
+		receiver = b.account.GetCosmosAddress().String()
+	}
+
+	receiverBech32, err := address.ToBech32(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenmngrtypes.MsgUnwrapToken{
+		Creator:  b.account.GetCosmosAddress().String(),
+		Amount:   attoAmount,
+		Receiver: receiverBech32,
+	}, nil
+}
+
+// ConvertToEVM converts usix into asix credited to the receiver on the EVM layer
+func (b *BalanceMsg) ConvertToEVM(amount sdk.Coin, receiver string) (*sdk.TxResponse, error) {
+	msg, err := b.BuildConvertToEVMMsg(amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.accountMsg.BroadcastTx(msg)
+}
+
+// ConvertToEVMAndWait converts usix into asix and waits for the transaction to be confirmed
+func (b *BalanceMsg) ConvertToEVMAndWait(amount sdk.Coin, receiver string) (*sdk.TxResponse, error) {
+	msg, err := b.BuildConvertToEVMMsg(amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.accountMsg.BroadcastTxAndWait(msg)
+}
+
+// ConvertToCosmos converts asix back into usix credited to the receiver on the Cosmos layer
+func (b *BalanceMsg) ConvertToCosmos(amount sdk.Coin, receiver string) (*sdk.TxResponse, error) {
+	msg, err := b.BuildConvertToCosmosMsg(amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.accountMsg.BroadcastTx(msg)
+}
+
+// ConvertToCosmosAndWait converts asix into usix and waits for the transaction to be confirmed
+func (b *BalanceMsg) ConvertToCosmosAndWait(amount sdk.Coin, receiver string) (*sdk.TxResponse, error) {
+	msg, err := b.BuildConvertToCosmosMsg(amount, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.accountMsg.BroadcastTxAndWait(msg)
+}
+
 // BroadcastTx broadcasts one or more messages
 // This allows for batch operations or custom message types
 func (b *BalanceMsg) BroadcastTx(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
@@ -0,0 +1,139 @@
+package evm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ContractOwner returns the admin of a certificate contract, the zero address once ownership is renounced
+func (e *EVMClient) ContractOwner(contractAddress common.Address) (common.Address, error) {
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	owner, err := contract.Owner(e.CallOpts())
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to get owner of %s: %w", contractAddress.Hex(), err)
+	}
+
+	return owner, nil
+}
+
+// SetBaseURI points the token URIs of a certificate contract at a new metadata base URI
+func (e *EVMClient) SetBaseURI(contractAddress common.Address, baseURI string) (*types.Transaction, error) {
+	if baseURI == "" {
+		return nil, fmt.Errorf("base URI cannot be empty")
+	}
+
+	// Role-based contracts gate the base URI with URI_SETTER_ROLE instead of the owner
+	accessControlled, err := CheckRole(e.GetClient().GetContext(), e.Backend(), contractAddress, URISetterRole, e.GetEVMAddress())
+	if err != nil {
+		return nil, err
+	}
+	if !accessControlled {
+		if err := e.requireContractOwner(contractAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.SetBaseURI(opts, baseURI)
+}
+
+// SetCertificateBaseURI points the token URIs of a certificate contract at the metadata API of nftSchemaCode on the connected network
+func (e *EVMClient) SetCertificateBaseURI(contractAddress common.Address, nftSchemaCode string) (*types.Transaction, error) {
+	return e.SetBaseURI(contractAddress, e.certificateBaseURI(nftSchemaCode))
+}
+
+// TransferContractOwnership hands the admin role of a certificate contract to newOwner
+func (e *EVMClient) TransferContractOwnership(contractAddress common.Address, newOwner common.Address) (*types.Transaction, error) {
+	if newOwner == (common.Address{}) {
+		return nil, fmt.Errorf("new owner cannot be the zero address, use RenounceOwnership")
+	}
+
+	if err := e.requireContractOwner(contractAddress); err != nil {
+		return nil, err
+	}
+
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.TransferOwnership(opts, newOwner)
+}
+
+// TransferContractOwnershipAndWait hands the admin role of a certificate contract to newOwner and checks the new owner on-chain
+func (e *EVMClient) TransferContractOwnershipAndWait(contractAddress common.Address, newOwner common.Address) (*types.Receipt, error) {
+	tx, err := e.TransferContractOwnership(contractAddress, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := e.GetClient().WaitForEVMTransaction(tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := e.ContractOwner(contractAddress)
+	if err != nil {
+		return receipt, err
+	}
+
+	if owner != newOwner {
+		return receipt, fmt.Errorf("owner of %s is %s after transfer, expected %s", contractAddress.Hex(), owner.Hex(), newOwner.Hex())
+	}
+
+	return receipt, nil
+}
+
+// RenounceOwnership removes the admin of a certificate contract for good
+// Minting and every other owner-only function become unusable
+func (e *EVMClient) RenounceOwnership(contractAddress common.Address) (*types.Transaction, error) {
+	if err := e.requireContractOwner(contractAddress); err != nil {
+		return nil, err
+	}
+
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.RenounceOwnership(opts)
+}
+
+// requireContractOwner fails early when this account is not the admin, instead of paying for a reverted transaction
+func (e *EVMClient) requireContractOwner(contractAddress common.Address) error {
+	owner, err := e.ContractOwner(contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if owner != e.GetEVMAddress() {
+		return fmt.Errorf("%s is not the owner of %s, owner is %s", e.GetEVMAddress().Hex(), contractAddress.Hex(), owner.Hex())
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ error NonExistentTokenURI();
 error InvalidSignature();
 error SignatureExpired();
 error InvalidSigner();
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
 
 contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
     using Strings for uint256;
@@ -33,7 +34,12 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
             "PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"
         );
 
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
     // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
     event safeMintEvent(address to, uint256 tokenId);
     event PermitUsed(
         address indexed owner,
@@ -59,6 +65,18 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
         _safeMint(to, tokenId);
     }
 
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyOwner {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+        }
+    }
+
     // BASE URI
     function _baseURI() internal view virtual override returns (string memory) {
         return _baseTokenURI;
@@ -66,6 +84,22 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
 
     function setBaseURI(string calldata baseURI) external onlyOwner {
         _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
     }
 
     function tokenURI(
@@ -251,6 +285,6 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
     function supportsInterface(
         bytes4 interfaceId
     ) public view override(ERC721, ERC721Enumerable) returns (bool) {
-        return super.supportsInterface(interfaceId);
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
     }
 }
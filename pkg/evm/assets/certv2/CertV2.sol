@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {ERC721} from "openzeppelin-contracts/token/ERC721/ERC721.sol";
+import {ERC721Enumerable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import {ERC721Burnable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Burnable.sol";
+import {Strings} from "openzeppelin-contracts/utils/Strings.sol";
+import {Ownable} from "openzeppelin-contracts/access/Ownable.sol";
+import {SignatureChecker} from "openzeppelin-contracts/utils/cryptography/SignatureChecker.sol";
+import {EIP712} from "openzeppelin-contracts/utils/cryptography/EIP712.sol";
+
+error NonExistentTokenURI();
+error SignatureExpired();
+error InvalidSigner();
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
+
+/**
+ * @dev Certificate contract with recipient-bound transfer permits.
+ *
+ * Differences from LBBCert:
+ * - transferWithSignature verifies a TransferPermit that names the recipient, so the
+ *   submitter of the signature cannot choose where the token goes.
+ * - Nonces are kept per owner and per purpose (the type hash of the signed struct), so
+ *   an unused Permit does not invalidate a TransferPermit signed afterwards.
+ * - Signatures are checked with SignatureChecker, owners may be EIP-1271 smart-contract wallets.
+ */
+contract LBBCertV2 is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
+    using Strings for uint256;
+    string private _baseTokenURI;
+
+    // Nonces per owner and per purpose
+    mapping(address => mapping(bytes32 => uint256)) private _nonces;
+
+    // EIP-712 Type Hashes, also the purposes of the nonces
+    bytes32 public constant PERMIT_TYPEHASH =
+        keccak256(
+            "Permit(address owner,address spender,uint256 tokenId,uint256 nonce,uint256 deadline)"
+        );
+
+    bytes32 public constant PERMIT_FOR_ALL_TYPEHASH =
+        keccak256(
+            "PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"
+        );
+
+    bytes32 public constant TRANSFER_PERMIT_TYPEHASH =
+        keccak256(
+            "TransferPermit(address owner,address to,uint256 tokenId,uint256 nonce,uint256 deadline)"
+        );
+
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
+    // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event safeMintEvent(address to, uint256 tokenId);
+    event PermitUsed(
+        address indexed owner,
+        address indexed spender,
+        uint256 tokenId
+    );
+    event PermitForAllUsed(
+        address indexed owner,
+        address indexed operator,
+        bool approved
+    );
+    event TransferPermitUsed(
+        address indexed owner,
+        address indexed to,
+        uint256 tokenId
+    );
+    event NonceCancelled(
+        address indexed owner,
+        bytes32 indexed purpose,
+        uint256 nonce
+    );
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        string memory baseURI,
+        address initialOwner
+    ) ERC721(name, symbol) EIP712(name, "1") Ownable(initialOwner) {
+        _baseTokenURI = baseURI;
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyOwner {
+        _safeMint(to, tokenId);
+    }
+
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyOwner {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+        }
+    }
+
+    // BASE URI
+    function _baseURI() internal view virtual override returns (string memory) {
+        return _baseTokenURI;
+    }
+
+    function setBaseURI(string calldata baseURI) external onlyOwner {
+        _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function tokenURI(
+        uint256 tokenId
+    ) public view virtual override returns (string memory) {
+        if (ownerOf(tokenId) == address(0)) {
+            revert NonExistentTokenURI();
+        }
+        return
+            bytes(_baseTokenURI).length > 0
+                ? string(abi.encodePacked(_baseTokenURI, tokenId.toString()))
+                : "";
+    }
+
+    // ============ EIP-712 Signature Functions ============
+
+    /**
+     * @dev Returns the current nonce of `owner` for `purpose`, the type hash of the signed struct.
+     */
+    function nonces(address owner, bytes32 purpose) public view returns (uint256) {
+        return _nonces[owner][purpose];
+    }
+
+    /**
+     * @dev Returns the domain separator for the current chain.
+     */
+    function DOMAIN_SEPARATOR() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+
+    /**
+     * @dev Invalidates every signature of the caller for `purpose` signed with the current nonce.
+     */
+    function cancelNonce(bytes32 purpose) external {
+        uint256 nonce = _nonces[msg.sender][purpose]++;
+        emit NonceCancelled(msg.sender, purpose, nonce);
+    }
+
+    /**
+     * @dev Permit approval for a specific token using EIP-712 signature
+     * @param owner The owner of the token
+     * @param spender The address to approve
+     * @param tokenId The token ID to approve
+     * @param deadline The deadline timestamp for the signature
+     * @param signature The ECDSA signature or EIP-1271 signature data of owner
+     */
+    function permit(
+        address owner,
+        address spender,
+        uint256 tokenId,
+        uint256 deadline,
+        bytes calldata signature
+    ) public {
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_TYPEHASH,
+                owner,
+                spender,
+                tokenId,
+                _useNonce(owner, PERMIT_TYPEHASH),
+                deadline
+            )
+        );
+        _checkSignature(owner, structHash, deadline, signature);
+
+        if (ownerOf(tokenId) != owner) {
+            revert InvalidSigner();
+        }
+
+        _approve(spender, tokenId, owner);
+        emit PermitUsed(owner, spender, tokenId);
+    }
+
+    /**
+     * @dev Permit approval for all tokens using EIP-712 signature (setApprovalForAll)
+     * @param owner The owner granting approval
+     * @param operator The operator to approve/revoke
+     * @param approved Whether to approve or revoke
+     * @param deadline The deadline timestamp for the signature
+     * @param signature The ECDSA signature or EIP-1271 signature data of owner
+     */
+    function permitForAll(
+        address owner,
+        address operator,
+        bool approved,
+        uint256 deadline,
+        bytes calldata signature
+    ) public {
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_FOR_ALL_TYPEHASH,
+                owner,
+                operator,
+                approved,
+                _useNonce(owner, PERMIT_FOR_ALL_TYPEHASH),
+                deadline
+            )
+        );
+        _checkSignature(owner, structHash, deadline, signature);
+
+        _setApprovalForAll(owner, operator, approved);
+        emit PermitForAllUsed(owner, operator, approved);
+    }
+
+    /**
+     * @dev Transfer token to the recipient named in a TransferPermit signature (gasless transfer)
+     * Anyone may submit the signature, the token can only go to `to`
+     * @param owner The current owner
+     * @param to The recipient signed by owner
+     * @param tokenId The token ID to transfer
+     * @param deadline The deadline timestamp for the signature
+     * @param signature The ECDSA signature or EIP-1271 signature data of owner
+     */
+    function transferWithSignature(
+        address owner,
+        address to,
+        uint256 tokenId,
+        uint256 deadline,
+        bytes calldata signature
+    ) public {
+        bytes32 structHash = keccak256(
+            abi.encode(
+                TRANSFER_PERMIT_TYPEHASH,
+                owner,
+                to,
+                tokenId,
+                _useNonce(owner, TRANSFER_PERMIT_TYPEHASH),
+                deadline
+            )
+        );
+        _checkSignature(owner, structHash, deadline, signature);
+
+        if (ownerOf(tokenId) != owner) {
+            revert InvalidSigner();
+        }
+
+        _safeTransfer(owner, to, tokenId);
+        emit TransferPermitUsed(owner, to, tokenId);
+    }
+
+    function burnWithPermit(
+        address owner,
+        uint256 tokenId,
+        uint256 deadline,
+        bytes calldata signature
+    ) public {
+        permit(owner, msg.sender, tokenId, deadline, signature);
+
+        burn(tokenId);
+    }
+
+    function _useNonce(address owner, bytes32 purpose) private returns (uint256) {
+        return _nonces[owner][purpose]++;
+    }
+
+    function _checkSignature(
+        address owner,
+        bytes32 structHash,
+        uint256 deadline,
+        bytes calldata signature
+    ) private view {
+        if (block.timestamp > deadline) {
+            revert SignatureExpired();
+        }
+
+        if (!SignatureChecker.isValidSignatureNow(owner, _hashTypedDataV4(structHash), signature)) {
+            revert InvalidSigner();
+        }
+    }
+
+    // ============ End of Signature Functions ============
+
+    // The following functions are overrides required by Solidity.
+
+    function _update(
+        address to,
+        uint256 tokenId,
+        address auth
+    ) internal override(ERC721, ERC721Enumerable) returns (address) {
+        return super._update(to, tokenId, auth);
+    }
+
+    function _increaseBalance(
+        address account,
+        uint128 value
+    ) internal override(ERC721, ERC721Enumerable) {
+        super._increaseBalance(account, value);
+    }
+
+    function supportsInterface(
+        bytes4 interfaceId
+    ) public view override(ERC721, ERC721Enumerable) returns (bool) {
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
+    }
+}
@@ -0,0 +1,2940 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package certv2
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LBBCertV2MetaData contains all meta data concerning the LBBCertV2 contract.
+var LBBCertV2MetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"initialOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PERMIT_FOR_ALL_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PERMIT_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TRANSFER_PERMIT_TYPEHASH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"burnWithPermit\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancelNonce\",\"inputs\":[{\"name\":\"purpose\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"emitBatchMetadataUpdate\",\"inputs\":[{\"name\":\"fromTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"toTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emitMetadataUpdate\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nonces\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"purpose\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"permit\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMintBatch\",\"inputs\":[{\"name\":\"to\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ids\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBaseURI\",\"inputs\":[{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenByIndex\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenOfOwnerByIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferWithSignature\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchMetadataUpdate\",\"inputs\":[{\"name\":\"_fromTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"_toTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MetadataUpdate\",\"inputs\":[{\"name\":\"_tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"NonceCancelled\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"purpose\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitForAllUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"TransferPermitUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"safeMintEvent\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"BatchLengthMismatch\",\"inputs\":[{\"name\":\"recipients\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tokenIds\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721EnumerableForbiddenBatchMint\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ERC721IncorrectOwner\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InsufficientApproval\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOperator\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721NonexistentToken\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721OutOfBoundsIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidShortString\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSigner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonExistentTokenURI\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SignatureExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"StringTooLong\",\"inputs\":[{\"name\":\"str\",\"type\":\"string\",\"internalType\":\"string\"}]}]",
+}
+
+// LBBCertV2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use LBBCertV2MetaData.ABI instead.
+var LBBCertV2ABI = LBBCertV2MetaData.ABI
+
+// LBBCertV2 is an auto generated Go binding around an Ethereum contract.
+type LBBCertV2 struct {
+	LBBCertV2Caller     // Read-only binding to the contract
+	LBBCertV2Transactor // Write-only binding to the contract
+	LBBCertV2Filterer   // Log filterer for contract events
+}
+
+// LBBCertV2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type LBBCertV2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertV2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type LBBCertV2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertV2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LBBCertV2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertV2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LBBCertV2Session struct {
+	Contract     *LBBCertV2        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LBBCertV2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LBBCertV2CallerSession struct {
+	Contract *LBBCertV2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// LBBCertV2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LBBCertV2TransactorSession struct {
+	Contract     *LBBCertV2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// LBBCertV2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type LBBCertV2Raw struct {
+	Contract *LBBCertV2 // Generic contract binding to access the raw methods on
+}
+
+// LBBCertV2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LBBCertV2CallerRaw struct {
+	Contract *LBBCertV2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// LBBCertV2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LBBCertV2TransactorRaw struct {
+	Contract *LBBCertV2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLBBCertV2 creates a new instance of LBBCertV2, bound to a specific deployed contract.
+func NewLBBCertV2(address common.Address, backend bind.ContractBackend) (*LBBCertV2, error) {
+	contract, err := bindLBBCertV2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2{LBBCertV2Caller: LBBCertV2Caller{contract: contract}, LBBCertV2Transactor: LBBCertV2Transactor{contract: contract}, LBBCertV2Filterer: LBBCertV2Filterer{contract: contract}}, nil
+}
+
+// NewLBBCertV2Caller creates a new read-only instance of LBBCertV2, bound to a specific deployed contract.
+func NewLBBCertV2Caller(address common.Address, caller bind.ContractCaller) (*LBBCertV2Caller, error) {
+	contract, err := bindLBBCertV2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2Caller{contract: contract}, nil
+}
+
+// NewLBBCertV2Transactor creates a new write-only instance of LBBCertV2, bound to a specific deployed contract.
+func NewLBBCertV2Transactor(address common.Address, transactor bind.ContractTransactor) (*LBBCertV2Transactor, error) {
+	contract, err := bindLBBCertV2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2Transactor{contract: contract}, nil
+}
+
+// NewLBBCertV2Filterer creates a new log filterer instance of LBBCertV2, bound to a specific deployed contract.
+func NewLBBCertV2Filterer(address common.Address, filterer bind.ContractFilterer) (*LBBCertV2Filterer, error) {
+	contract, err := bindLBBCertV2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2Filterer{contract: contract}, nil
+}
+
+// bindLBBCertV2 binds a generic wrapper to an already deployed contract.
+func bindLBBCertV2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LBBCertV2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertV2 *LBBCertV2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertV2.Contract.LBBCertV2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertV2 *LBBCertV2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.LBBCertV2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertV2 *LBBCertV2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.LBBCertV2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertV2 *LBBCertV2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertV2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertV2 *LBBCertV2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertV2 *LBBCertV2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Caller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Session) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCertV2.Contract.DOMAINSEPARATOR(&_LBBCertV2.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2CallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCertV2.Contract.DOMAINSEPARATOR(&_LBBCertV2.CallOpts)
+}
+
+// PERMITFORALLTYPEHASH is a free data retrieval call binding the contract method 0x585956d6.
+//
+// Solidity: function PERMIT_FOR_ALL_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Caller) PERMITFORALLTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "PERMIT_FOR_ALL_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PERMITFORALLTYPEHASH is a free data retrieval call binding the contract method 0x585956d6.
+//
+// Solidity: function PERMIT_FOR_ALL_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Session) PERMITFORALLTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.PERMITFORALLTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// PERMITFORALLTYPEHASH is a free data retrieval call binding the contract method 0x585956d6.
+//
+// Solidity: function PERMIT_FOR_ALL_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2CallerSession) PERMITFORALLTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.PERMITFORALLTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// PERMITTYPEHASH is a free data retrieval call binding the contract method 0x30adf81f.
+//
+// Solidity: function PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Caller) PERMITTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "PERMIT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PERMITTYPEHASH is a free data retrieval call binding the contract method 0x30adf81f.
+//
+// Solidity: function PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Session) PERMITTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.PERMITTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// PERMITTYPEHASH is a free data retrieval call binding the contract method 0x30adf81f.
+//
+// Solidity: function PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2CallerSession) PERMITTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.PERMITTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// TRANSFERPERMITTYPEHASH is a free data retrieval call binding the contract method 0xe59ba924.
+//
+// Solidity: function TRANSFER_PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Caller) TRANSFERPERMITTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "TRANSFER_PERMIT_TYPEHASH")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TRANSFERPERMITTYPEHASH is a free data retrieval call binding the contract method 0xe59ba924.
+//
+// Solidity: function TRANSFER_PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2Session) TRANSFERPERMITTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.TRANSFERPERMITTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// TRANSFERPERMITTYPEHASH is a free data retrieval call binding the contract method 0xe59ba924.
+//
+// Solidity: function TRANSFER_PERMIT_TYPEHASH() view returns(bytes32)
+func (_LBBCertV2 *LBBCertV2CallerSession) TRANSFERPERMITTYPEHASH() ([32]byte, error) {
+	return _LBBCertV2.Contract.TRANSFERPERMITTYPEHASH(&_LBBCertV2.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Caller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Session) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCertV2.Contract.BalanceOf(&_LBBCertV2.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2CallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCertV2.Contract.BalanceOf(&_LBBCertV2.CallOpts, owner)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertV2 *LBBCertV2Caller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertV2 *LBBCertV2Session) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCertV2.Contract.Eip712Domain(&_LBBCertV2.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertV2 *LBBCertV2CallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCertV2.Contract.Eip712Domain(&_LBBCertV2.CallOpts)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2Caller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2Session) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertV2.Contract.GetApproved(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2CallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertV2.Contract.GetApproved(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertV2 *LBBCertV2Caller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertV2 *LBBCertV2Session) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCertV2.Contract.IsApprovedForAll(&_LBBCertV2.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertV2 *LBBCertV2CallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCertV2.Contract.IsApprovedForAll(&_LBBCertV2.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertV2 *LBBCertV2Caller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertV2 *LBBCertV2Session) Name() (string, error) {
+	return _LBBCertV2.Contract.Name(&_LBBCertV2.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertV2 *LBBCertV2CallerSession) Name() (string, error) {
+	return _LBBCertV2.Contract.Name(&_LBBCertV2.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0xd7233651.
+//
+// Solidity: function nonces(address owner, bytes32 purpose) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Caller) Nonces(opts *bind.CallOpts, owner common.Address, purpose [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "nonces", owner, purpose)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0xd7233651.
+//
+// Solidity: function nonces(address owner, bytes32 purpose) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Session) Nonces(owner common.Address, purpose [32]byte) (*big.Int, error) {
+	return _LBBCertV2.Contract.Nonces(&_LBBCertV2.CallOpts, owner, purpose)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0xd7233651.
+//
+// Solidity: function nonces(address owner, bytes32 purpose) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2CallerSession) Nonces(owner common.Address, purpose [32]byte) (*big.Int, error) {
+	return _LBBCertV2.Contract.Nonces(&_LBBCertV2.CallOpts, owner, purpose)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertV2 *LBBCertV2Caller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertV2 *LBBCertV2Session) Owner() (common.Address, error) {
+	return _LBBCertV2.Contract.Owner(&_LBBCertV2.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertV2 *LBBCertV2CallerSession) Owner() (common.Address, error) {
+	return _LBBCertV2.Contract.Owner(&_LBBCertV2.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2Caller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2Session) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertV2.Contract.OwnerOf(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertV2 *LBBCertV2CallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertV2.Contract.OwnerOf(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertV2 *LBBCertV2Caller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertV2 *LBBCertV2Session) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCertV2.Contract.SupportsInterface(&_LBBCertV2.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertV2 *LBBCertV2CallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCertV2.Contract.SupportsInterface(&_LBBCertV2.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertV2 *LBBCertV2Caller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertV2 *LBBCertV2Session) Symbol() (string, error) {
+	return _LBBCertV2.Contract.Symbol(&_LBBCertV2.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertV2 *LBBCertV2CallerSession) Symbol() (string, error) {
+	return _LBBCertV2.Contract.Symbol(&_LBBCertV2.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Caller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Session) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCertV2.Contract.TokenByIndex(&_LBBCertV2.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2CallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCertV2.Contract.TokenByIndex(&_LBBCertV2.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Caller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Session) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCertV2.Contract.TokenOfOwnerByIndex(&_LBBCertV2.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertV2 *LBBCertV2CallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCertV2.Contract.TokenOfOwnerByIndex(&_LBBCertV2.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertV2 *LBBCertV2Caller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertV2 *LBBCertV2Session) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCertV2.Contract.TokenURI(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertV2 *LBBCertV2CallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCertV2.Contract.TokenURI(&_LBBCertV2.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Caller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertV2.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertV2 *LBBCertV2Session) TotalSupply() (*big.Int, error) {
+	return _LBBCertV2.Contract.TotalSupply(&_LBBCertV2.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertV2 *LBBCertV2CallerSession) TotalSupply() (*big.Int, error) {
+	return _LBBCertV2.Contract.TotalSupply(&_LBBCertV2.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Approve(&_LBBCertV2.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Approve(&_LBBCertV2.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Burn(&_LBBCertV2.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Burn(&_LBBCertV2.TransactOpts, tokenId)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0x66c7e2b5.
+//
+// Solidity: function burnWithPermit(address owner, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) BurnWithPermit(opts *bind.TransactOpts, owner common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "burnWithPermit", owner, tokenId, deadline, signature)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0x66c7e2b5.
+//
+// Solidity: function burnWithPermit(address owner, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Session) BurnWithPermit(owner common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.BurnWithPermit(&_LBBCertV2.TransactOpts, owner, tokenId, deadline, signature)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0x66c7e2b5.
+//
+// Solidity: function burnWithPermit(address owner, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) BurnWithPermit(owner common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.BurnWithPermit(&_LBBCertV2.TransactOpts, owner, tokenId, deadline, signature)
+}
+
+// CancelNonce is a paid mutator transaction binding the contract method 0x4029b92b.
+//
+// Solidity: function cancelNonce(bytes32 purpose) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) CancelNonce(opts *bind.TransactOpts, purpose [32]byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "cancelNonce", purpose)
+}
+
+// CancelNonce is a paid mutator transaction binding the contract method 0x4029b92b.
+//
+// Solidity: function cancelNonce(bytes32 purpose) returns()
+func (_LBBCertV2 *LBBCertV2Session) CancelNonce(purpose [32]byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.CancelNonce(&_LBBCertV2.TransactOpts, purpose)
+}
+
+// CancelNonce is a paid mutator transaction binding the contract method 0x4029b92b.
+//
+// Solidity: function cancelNonce(bytes32 purpose) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) CancelNonce(purpose [32]byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.CancelNonce(&_LBBCertV2.TransactOpts, purpose)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) EmitBatchMetadataUpdate(opts *bind.TransactOpts, fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "emitBatchMetadataUpdate", fromTokenId, toTokenId)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) EmitBatchMetadataUpdate(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.EmitBatchMetadataUpdate(&_LBBCertV2.TransactOpts, fromTokenId, toTokenId)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) EmitBatchMetadataUpdate(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.EmitBatchMetadataUpdate(&_LBBCertV2.TransactOpts, fromTokenId, toTokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) EmitMetadataUpdate(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "emitMetadataUpdate", tokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) EmitMetadataUpdate(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.EmitMetadataUpdate(&_LBBCertV2.TransactOpts, tokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) EmitMetadataUpdate(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.EmitMetadataUpdate(&_LBBCertV2.TransactOpts, tokenId)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0x9fd5a6cf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "permit", owner, spender, tokenId, deadline, signature)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0x9fd5a6cf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Session) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Permit(&_LBBCertV2.TransactOpts, owner, spender, tokenId, deadline, signature)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0x9fd5a6cf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.Permit(&_LBBCertV2.TransactOpts, owner, spender, tokenId, deadline, signature)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0xfe3424ee.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) PermitForAll(opts *bind.TransactOpts, owner common.Address, operator common.Address, approved bool, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "permitForAll", owner, operator, approved, deadline, signature)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0xfe3424ee.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Session) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.PermitForAll(&_LBBCertV2.TransactOpts, owner, operator, approved, deadline, signature)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0xfe3424ee.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.PermitForAll(&_LBBCertV2.TransactOpts, owner, operator, approved, deadline, signature)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertV2 *LBBCertV2Transactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertV2 *LBBCertV2Session) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCertV2.Contract.RenounceOwnership(&_LBBCertV2.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCertV2.Contract.RenounceOwnership(&_LBBCertV2.TransactOpts)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SafeMint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "safeMint", to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeMint(&_LBBCertV2.TransactOpts, to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeMint(&_LBBCertV2.TransactOpts, to, tokenId)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SafeMintBatch(opts *bind.TransactOpts, to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "safeMintBatch", to, ids)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertV2 *LBBCertV2Session) SafeMintBatch(to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeMintBatch(&_LBBCertV2.TransactOpts, to, ids)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SafeMintBatch(to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeMintBatch(&_LBBCertV2.TransactOpts, to, ids)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeTransferFrom(&_LBBCertV2.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeTransferFrom(&_LBBCertV2.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertV2 *LBBCertV2Session) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeTransferFrom0(&_LBBCertV2.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SafeTransferFrom0(&_LBBCertV2.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertV2 *LBBCertV2Session) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SetApprovalForAll(&_LBBCertV2.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SetApprovalForAll(&_LBBCertV2.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) SetBaseURI(opts *bind.TransactOpts, baseURI string) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "setBaseURI", baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertV2 *LBBCertV2Session) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SetBaseURI(&_LBBCertV2.TransactOpts, baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.SetBaseURI(&_LBBCertV2.TransactOpts, baseURI)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2Session) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferFrom(&_LBBCertV2.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferFrom(&_LBBCertV2.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertV2 *LBBCertV2Session) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferOwnership(&_LBBCertV2.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferOwnership(&_LBBCertV2.TransactOpts, newOwner)
+}
+
+// TransferWithSignature is a paid mutator transaction binding the contract method 0xbf8ef94d.
+//
+// Solidity: function transferWithSignature(address owner, address to, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Transactor) TransferWithSignature(opts *bind.TransactOpts, owner common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.contract.Transact(opts, "transferWithSignature", owner, to, tokenId, deadline, signature)
+}
+
+// TransferWithSignature is a paid mutator transaction binding the contract method 0xbf8ef94d.
+//
+// Solidity: function transferWithSignature(address owner, address to, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2Session) TransferWithSignature(owner common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferWithSignature(&_LBBCertV2.TransactOpts, owner, to, tokenId, deadline, signature)
+}
+
+// TransferWithSignature is a paid mutator transaction binding the contract method 0xbf8ef94d.
+//
+// Solidity: function transferWithSignature(address owner, address to, uint256 tokenId, uint256 deadline, bytes signature) returns()
+func (_LBBCertV2 *LBBCertV2TransactorSession) TransferWithSignature(owner common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, signature []byte) (*types.Transaction, error) {
+	return _LBBCertV2.Contract.TransferWithSignature(&_LBBCertV2.TransactOpts, owner, to, tokenId, deadline, signature)
+}
+
+// LBBCertV2ApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LBBCertV2 contract.
+type LBBCertV2ApprovalIterator struct {
+	Event *LBBCertV2Approval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2ApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2Approval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2Approval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2ApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2ApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2Approval represents a Approval event raised by the LBBCertV2 contract.
+type LBBCertV2Approval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*LBBCertV2ApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2ApprovalIterator{contract: _LBBCertV2.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LBBCertV2Approval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2Approval)
+				if err := _LBBCertV2.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseApproval(log types.Log) (*LBBCertV2Approval, error) {
+	event := new(LBBCertV2Approval)
+	if err := _LBBCertV2.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2ApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the LBBCertV2 contract.
+type LBBCertV2ApprovalForAllIterator struct {
+	Event *LBBCertV2ApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2ApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2ApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2ApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2ApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2ApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2ApprovalForAll represents a ApprovalForAll event raised by the LBBCertV2 contract.
+type LBBCertV2ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertV2ApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2ApprovalForAllIterator{contract: _LBBCertV2.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *LBBCertV2ApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2ApprovalForAll)
+				if err := _LBBCertV2.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseApprovalForAll(log types.Log) (*LBBCertV2ApprovalForAll, error) {
+	event := new(LBBCertV2ApprovalForAll)
+	if err := _LBBCertV2.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2BatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the LBBCertV2 contract.
+type LBBCertV2BatchMetadataUpdateIterator struct {
+	Event *LBBCertV2BatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2BatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2BatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2BatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2BatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2BatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2BatchMetadataUpdate represents a BatchMetadataUpdate event raised by the LBBCertV2 contract.
+type LBBCertV2BatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*LBBCertV2BatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2BatchMetadataUpdateIterator{contract: _LBBCertV2.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LBBCertV2BatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2BatchMetadataUpdate)
+				if err := _LBBCertV2.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseBatchMetadataUpdate(log types.Log) (*LBBCertV2BatchMetadataUpdate, error) {
+	event := new(LBBCertV2BatchMetadataUpdate)
+	if err := _LBBCertV2.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2EIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the LBBCertV2 contract.
+type LBBCertV2EIP712DomainChangedIterator struct {
+	Event *LBBCertV2EIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2EIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2EIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2EIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2EIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2EIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2EIP712DomainChanged represents a EIP712DomainChanged event raised by the LBBCertV2 contract.
+type LBBCertV2EIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertV2 *LBBCertV2Filterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*LBBCertV2EIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2EIP712DomainChangedIterator{contract: _LBBCertV2.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertV2 *LBBCertV2Filterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *LBBCertV2EIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2EIP712DomainChanged)
+				if err := _LBBCertV2.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertV2 *LBBCertV2Filterer) ParseEIP712DomainChanged(log types.Log) (*LBBCertV2EIP712DomainChanged, error) {
+	event := new(LBBCertV2EIP712DomainChanged)
+	if err := _LBBCertV2.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2MetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the LBBCertV2 contract.
+type LBBCertV2MetadataUpdateIterator struct {
+	Event *LBBCertV2MetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2MetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2MetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2MetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2MetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2MetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2MetadataUpdate represents a MetadataUpdate event raised by the LBBCertV2 contract.
+type LBBCertV2MetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*LBBCertV2MetadataUpdateIterator, error) {
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2MetadataUpdateIterator{contract: _LBBCertV2.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LBBCertV2MetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2MetadataUpdate)
+				if err := _LBBCertV2.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseMetadataUpdate(log types.Log) (*LBBCertV2MetadataUpdate, error) {
+	event := new(LBBCertV2MetadataUpdate)
+	if err := _LBBCertV2.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2NonceCancelledIterator is returned from FilterNonceCancelled and is used to iterate over the raw logs and unpacked data for NonceCancelled events raised by the LBBCertV2 contract.
+type LBBCertV2NonceCancelledIterator struct {
+	Event *LBBCertV2NonceCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2NonceCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2NonceCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2NonceCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2NonceCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2NonceCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2NonceCancelled represents a NonceCancelled event raised by the LBBCertV2 contract.
+type LBBCertV2NonceCancelled struct {
+	Owner   common.Address
+	Purpose [32]byte
+	Nonce   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterNonceCancelled is a free log retrieval operation binding the contract event 0x0982404f88cf20a8575f65dce85663110a48bd74aaf7aaaf6d40105ce655761e.
+//
+// Solidity: event NonceCancelled(address indexed owner, bytes32 indexed purpose, uint256 nonce)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterNonceCancelled(opts *bind.FilterOpts, owner []common.Address, purpose [][32]byte) (*LBBCertV2NonceCancelledIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var purposeRule []interface{}
+	for _, purposeItem := range purpose {
+		purposeRule = append(purposeRule, purposeItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "NonceCancelled", ownerRule, purposeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2NonceCancelledIterator{contract: _LBBCertV2.contract, event: "NonceCancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchNonceCancelled is a free log subscription operation binding the contract event 0x0982404f88cf20a8575f65dce85663110a48bd74aaf7aaaf6d40105ce655761e.
+//
+// Solidity: event NonceCancelled(address indexed owner, bytes32 indexed purpose, uint256 nonce)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchNonceCancelled(opts *bind.WatchOpts, sink chan<- *LBBCertV2NonceCancelled, owner []common.Address, purpose [][32]byte) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var purposeRule []interface{}
+	for _, purposeItem := range purpose {
+		purposeRule = append(purposeRule, purposeItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "NonceCancelled", ownerRule, purposeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2NonceCancelled)
+				if err := _LBBCertV2.contract.UnpackLog(event, "NonceCancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNonceCancelled is a log parse operation binding the contract event 0x0982404f88cf20a8575f65dce85663110a48bd74aaf7aaaf6d40105ce655761e.
+//
+// Solidity: event NonceCancelled(address indexed owner, bytes32 indexed purpose, uint256 nonce)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseNonceCancelled(log types.Log) (*LBBCertV2NonceCancelled, error) {
+	event := new(LBBCertV2NonceCancelled)
+	if err := _LBBCertV2.contract.UnpackLog(event, "NonceCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2OwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LBBCertV2 contract.
+type LBBCertV2OwnershipTransferredIterator struct {
+	Event *LBBCertV2OwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2OwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2OwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2OwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2OwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2OwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2OwnershipTransferred represents a OwnershipTransferred event raised by the LBBCertV2 contract.
+type LBBCertV2OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LBBCertV2OwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2OwnershipTransferredIterator{contract: _LBBCertV2.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LBBCertV2OwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2OwnershipTransferred)
+				if err := _LBBCertV2.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseOwnershipTransferred(log types.Log) (*LBBCertV2OwnershipTransferred, error) {
+	event := new(LBBCertV2OwnershipTransferred)
+	if err := _LBBCertV2.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2PermitForAllUsedIterator is returned from FilterPermitForAllUsed and is used to iterate over the raw logs and unpacked data for PermitForAllUsed events raised by the LBBCertV2 contract.
+type LBBCertV2PermitForAllUsedIterator struct {
+	Event *LBBCertV2PermitForAllUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2PermitForAllUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2PermitForAllUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2PermitForAllUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2PermitForAllUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2PermitForAllUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2PermitForAllUsed represents a PermitForAllUsed event raised by the LBBCertV2 contract.
+type LBBCertV2PermitForAllUsed struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitForAllUsed is a free log retrieval operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterPermitForAllUsed(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertV2PermitForAllUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2PermitForAllUsedIterator{contract: _LBBCertV2.contract, event: "PermitForAllUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitForAllUsed is a free log subscription operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchPermitForAllUsed(opts *bind.WatchOpts, sink chan<- *LBBCertV2PermitForAllUsed, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2PermitForAllUsed)
+				if err := _LBBCertV2.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitForAllUsed is a log parse operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertV2 *LBBCertV2Filterer) ParsePermitForAllUsed(log types.Log) (*LBBCertV2PermitForAllUsed, error) {
+	event := new(LBBCertV2PermitForAllUsed)
+	if err := _LBBCertV2.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2PermitUsedIterator is returned from FilterPermitUsed and is used to iterate over the raw logs and unpacked data for PermitUsed events raised by the LBBCertV2 contract.
+type LBBCertV2PermitUsedIterator struct {
+	Event *LBBCertV2PermitUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2PermitUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2PermitUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2PermitUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2PermitUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2PermitUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2PermitUsed represents a PermitUsed event raised by the LBBCertV2 contract.
+type LBBCertV2PermitUsed struct {
+	Owner   common.Address
+	Spender common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitUsed is a free log retrieval operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterPermitUsed(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*LBBCertV2PermitUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2PermitUsedIterator{contract: _LBBCertV2.contract, event: "PermitUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitUsed is a free log subscription operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchPermitUsed(opts *bind.WatchOpts, sink chan<- *LBBCertV2PermitUsed, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2PermitUsed)
+				if err := _LBBCertV2.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitUsed is a log parse operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParsePermitUsed(log types.Log) (*LBBCertV2PermitUsed, error) {
+	event := new(LBBCertV2PermitUsed)
+	if err := _LBBCertV2.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2TransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LBBCertV2 contract.
+type LBBCertV2TransferIterator struct {
+	Event *LBBCertV2Transfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2TransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2Transfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2Transfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2TransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2TransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2Transfer represents a Transfer event raised by the LBBCertV2 contract.
+type LBBCertV2Transfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*LBBCertV2TransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2TransferIterator{contract: _LBBCertV2.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LBBCertV2Transfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2Transfer)
+				if err := _LBBCertV2.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseTransfer(log types.Log) (*LBBCertV2Transfer, error) {
+	event := new(LBBCertV2Transfer)
+	if err := _LBBCertV2.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2TransferPermitUsedIterator is returned from FilterTransferPermitUsed and is used to iterate over the raw logs and unpacked data for TransferPermitUsed events raised by the LBBCertV2 contract.
+type LBBCertV2TransferPermitUsedIterator struct {
+	Event *LBBCertV2TransferPermitUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2TransferPermitUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2TransferPermitUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2TransferPermitUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2TransferPermitUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2TransferPermitUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2TransferPermitUsed represents a TransferPermitUsed event raised by the LBBCertV2 contract.
+type LBBCertV2TransferPermitUsed struct {
+	Owner   common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferPermitUsed is a free log retrieval operation binding the contract event 0xd1ec37f3fbe42b2a69b8124ffc51ed8c55224d429eba8ddc19ed774297b6563e.
+//
+// Solidity: event TransferPermitUsed(address indexed owner, address indexed to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterTransferPermitUsed(opts *bind.FilterOpts, owner []common.Address, to []common.Address) (*LBBCertV2TransferPermitUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "TransferPermitUsed", ownerRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2TransferPermitUsedIterator{contract: _LBBCertV2.contract, event: "TransferPermitUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferPermitUsed is a free log subscription operation binding the contract event 0xd1ec37f3fbe42b2a69b8124ffc51ed8c55224d429eba8ddc19ed774297b6563e.
+//
+// Solidity: event TransferPermitUsed(address indexed owner, address indexed to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchTransferPermitUsed(opts *bind.WatchOpts, sink chan<- *LBBCertV2TransferPermitUsed, owner []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "TransferPermitUsed", ownerRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2TransferPermitUsed)
+				if err := _LBBCertV2.contract.UnpackLog(event, "TransferPermitUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferPermitUsed is a log parse operation binding the contract event 0xd1ec37f3fbe42b2a69b8124ffc51ed8c55224d429eba8ddc19ed774297b6563e.
+//
+// Solidity: event TransferPermitUsed(address indexed owner, address indexed to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseTransferPermitUsed(log types.Log) (*LBBCertV2TransferPermitUsed, error) {
+	event := new(LBBCertV2TransferPermitUsed)
+	if err := _LBBCertV2.contract.UnpackLog(event, "TransferPermitUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertV2SafeMintEventIterator is returned from FilterSafeMintEvent and is used to iterate over the raw logs and unpacked data for SafeMintEvent events raised by the LBBCertV2 contract.
+type LBBCertV2SafeMintEventIterator struct {
+	Event *LBBCertV2SafeMintEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertV2SafeMintEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertV2SafeMintEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertV2SafeMintEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertV2SafeMintEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertV2SafeMintEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertV2SafeMintEvent represents a SafeMintEvent event raised by the LBBCertV2 contract.
+type LBBCertV2SafeMintEvent struct {
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeMintEvent is a free log retrieval operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) FilterSafeMintEvent(opts *bind.FilterOpts) (*LBBCertV2SafeMintEventIterator, error) {
+
+	logs, sub, err := _LBBCertV2.contract.FilterLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertV2SafeMintEventIterator{contract: _LBBCertV2.contract, event: "safeMintEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeMintEvent is a free log subscription operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) WatchSafeMintEvent(opts *bind.WatchOpts, sink chan<- *LBBCertV2SafeMintEvent) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertV2.contract.WatchLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertV2SafeMintEvent)
+				if err := _LBBCertV2.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeMintEvent is a log parse operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertV2 *LBBCertV2Filterer) ParseSafeMintEvent(log types.Log) (*LBBCertV2SafeMintEvent, error) {
+	event := new(LBBCertV2SafeMintEvent)
+	if err := _LBBCertV2.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
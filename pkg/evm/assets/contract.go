@@ -1,5 +1,7 @@
 package assets
 
+//go:generate abigen --abi contract.abi --bin contract.bin --pkg assets --type LBBCert --out lbbcert.go
+
 import (
 	"embed"
 	"fmt"
@@ -0,0 +1,58 @@
+package assets_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/increment"
+)
+
+// The generated bindings must stay in sync with the embedded artifacts; rerun go generate when they change
+func TestBindingsMatchArtifacts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		abiFn    func() (string, error)
+		binFn    func() (string, error)
+		metadata func() (*abi.ABI, error)
+		bin      string
+	}{
+		{"LBBCert", assets.GetContractABIString, assets.GetContractBINString, assets.LBBCertMetaData.GetAbi, assets.LBBCertMetaData.Bin},
+		{"CertAutoID", increment.GetContractABIString, increment.GetContractBINString, increment.CertAutoIDMetaData.GetAbi, increment.CertAutoIDMetaData.Bin},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stringABI, err := tc.abiFn()
+			require.NoError(t, err)
+
+			embedded, err := abi.JSON(strings.NewReader(stringABI))
+			require.NoError(t, err)
+
+			bound, err := tc.metadata()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(embedded.Methods), len(bound.Methods))
+			for name, method := range embedded.Methods {
+				require.Contains(t, bound.Methods, name)
+				assert.Equal(t, method.Sig, bound.Methods[name].Sig)
+			}
+			for name := range embedded.Events {
+				assert.Contains(t, bound.Events, name)
+			}
+
+			stringBIN, err := tc.binFn()
+			require.NoError(t, err)
+			assert.Equal(t, "0x"+strings.TrimSpace(stringBIN), tc.bin)
+
+			// The parsed ABI is cached and shared by every binding
+			again, err := tc.metadata()
+			require.NoError(t, err)
+			assert.Same(t, bound, again)
+		})
+	}
+}
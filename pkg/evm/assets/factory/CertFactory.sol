@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {Create2} from "openzeppelin-contracts/utils/Create2.sol";
+import {LBBCert} from "./Cert.sol";
+
+/**
+ * @dev Deploys LBBCert with CREATE2 so the address of a schema's contract is known before it exists.
+ *
+ * The address depends on the factory, the deployer, the salt, the name and the symbol. The base URI
+ * is set after deployment, so a factory at the same address on fivenet and sixnet gives the same
+ * certificate address on both. The deployer is hashed into the salt, nobody else can take its address.
+ */
+contract LBBCertFactory {
+    event CertificateDeployed(address indexed certificate, address indexed owner, bytes32 indexed salt);
+
+    function deployCertificate(
+        string calldata name,
+        string calldata symbol,
+        string calldata baseURI,
+        bytes32 salt
+    ) external returns (address) {
+        LBBCert certificate = new LBBCert{salt: _deployerSalt(msg.sender, salt)}(name, symbol, "", address(this));
+        certificate.setBaseURI(baseURI);
+        certificate.transferOwnership(msg.sender);
+
+        emit CertificateDeployed(address(certificate), msg.sender, salt);
+        return address(certificate);
+    }
+
+    function predictCertificateAddress(
+        address deployer,
+        string calldata name,
+        string calldata symbol,
+        bytes32 salt
+    ) external view returns (address) {
+        bytes32 initCodeHash = keccak256(
+            abi.encodePacked(type(LBBCert).creationCode, abi.encode(name, symbol, "", address(this)))
+        );
+        return Create2.computeAddress(_deployerSalt(deployer, salt), initCodeHash);
+    }
+
+    function _deployerSalt(address deployer, bytes32 salt) private pure returns (bytes32) {
+        return keccak256(abi.encode(deployer, salt));
+    }
+}
@@ -0,0 +1,35 @@
+package factory
+
+// contract.abi and contract.bin are written by `make contracts`, in the same build as the bundled LBBCert it deploys
+//go:generate abigen --abi contract.abi --bin contract.bin --pkg factory --type LBBCertFactory --out lbbcertfactory.go
+
+import (
+	"embed"
+	"fmt"
+)
+
+//go:embed contract.abi
+var contractABI embed.FS
+
+func GetContractABIBytes() ([]byte, error) {
+	var contractABIByte []byte
+
+	contractABIByte, err := contractABI.ReadFile("contract.abi")
+	if err != nil {
+		return contractABIByte, fmt.Errorf("error on reading contract.abi file: %+v", err)
+	}
+
+	return contractABIByte, nil
+}
+
+func GetContractABIString() (abi string, err error) {
+	var stringABI string
+	abiBytes, err := GetContractABIBytes()
+	if err != nil {
+		return stringABI, err
+	}
+
+	stringABI = string(abiBytes)
+
+	return stringABI, err
+}
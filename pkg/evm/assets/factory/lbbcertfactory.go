@@ -0,0 +1,395 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package factory
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LBBCertFactoryMetaData contains all meta data concerning the LBBCertFactory contract.
+var LBBCertFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"deployCertificate\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"predictCertificateAddress\",\"inputs\":[{\"name\":\"deployer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"CertificateDeployed\",\"inputs\":[{\"name\":\"certificate\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false}]",
+}
+
+// LBBCertFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use LBBCertFactoryMetaData.ABI instead.
+var LBBCertFactoryABI = LBBCertFactoryMetaData.ABI
+
+// LBBCertFactory is an auto generated Go binding around an Ethereum contract.
+type LBBCertFactory struct {
+	LBBCertFactoryCaller     // Read-only binding to the contract
+	LBBCertFactoryTransactor // Write-only binding to the contract
+	LBBCertFactoryFilterer   // Log filterer for contract events
+}
+
+// LBBCertFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LBBCertFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LBBCertFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LBBCertFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LBBCertFactorySession struct {
+	Contract     *LBBCertFactory   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LBBCertFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LBBCertFactoryCallerSession struct {
+	Contract *LBBCertFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// LBBCertFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LBBCertFactoryTransactorSession struct {
+	Contract     *LBBCertFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// LBBCertFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LBBCertFactoryRaw struct {
+	Contract *LBBCertFactory // Generic contract binding to access the raw methods on
+}
+
+// LBBCertFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LBBCertFactoryCallerRaw struct {
+	Contract *LBBCertFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LBBCertFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LBBCertFactoryTransactorRaw struct {
+	Contract *LBBCertFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLBBCertFactory creates a new instance of LBBCertFactory, bound to a specific deployed contract.
+func NewLBBCertFactory(address common.Address, backend bind.ContractBackend) (*LBBCertFactory, error) {
+	contract, err := bindLBBCertFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFactory{LBBCertFactoryCaller: LBBCertFactoryCaller{contract: contract}, LBBCertFactoryTransactor: LBBCertFactoryTransactor{contract: contract}, LBBCertFactoryFilterer: LBBCertFactoryFilterer{contract: contract}}, nil
+}
+
+// NewLBBCertFactoryCaller creates a new read-only instance of LBBCertFactory, bound to a specific deployed contract.
+func NewLBBCertFactoryCaller(address common.Address, caller bind.ContractCaller) (*LBBCertFactoryCaller, error) {
+	contract, err := bindLBBCertFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFactoryCaller{contract: contract}, nil
+}
+
+// NewLBBCertFactoryTransactor creates a new write-only instance of LBBCertFactory, bound to a specific deployed contract.
+func NewLBBCertFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*LBBCertFactoryTransactor, error) {
+	contract, err := bindLBBCertFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFactoryTransactor{contract: contract}, nil
+}
+
+// NewLBBCertFactoryFilterer creates a new log filterer instance of LBBCertFactory, bound to a specific deployed contract.
+func NewLBBCertFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*LBBCertFactoryFilterer, error) {
+	contract, err := bindLBBCertFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFactoryFilterer{contract: contract}, nil
+}
+
+// bindLBBCertFactory binds a generic wrapper to an already deployed contract.
+func bindLBBCertFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LBBCertFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertFactory *LBBCertFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertFactory.Contract.LBBCertFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertFactory *LBBCertFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.LBBCertFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertFactory *LBBCertFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.LBBCertFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertFactory *LBBCertFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertFactory *LBBCertFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertFactory *LBBCertFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// PredictCertificateAddress is a free data retrieval call binding the contract method 0x3abc2b4d.
+//
+// Solidity: function predictCertificateAddress(address deployer, string name, string symbol, bytes32 salt) view returns(address)
+func (_LBBCertFactory *LBBCertFactoryCaller) PredictCertificateAddress(opts *bind.CallOpts, deployer common.Address, name string, symbol string, salt [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertFactory.contract.Call(opts, &out, "predictCertificateAddress", deployer, name, symbol, salt)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PredictCertificateAddress is a free data retrieval call binding the contract method 0x3abc2b4d.
+//
+// Solidity: function predictCertificateAddress(address deployer, string name, string symbol, bytes32 salt) view returns(address)
+func (_LBBCertFactory *LBBCertFactorySession) PredictCertificateAddress(deployer common.Address, name string, symbol string, salt [32]byte) (common.Address, error) {
+	return _LBBCertFactory.Contract.PredictCertificateAddress(&_LBBCertFactory.CallOpts, deployer, name, symbol, salt)
+}
+
+// PredictCertificateAddress is a free data retrieval call binding the contract method 0x3abc2b4d.
+//
+// Solidity: function predictCertificateAddress(address deployer, string name, string symbol, bytes32 salt) view returns(address)
+func (_LBBCertFactory *LBBCertFactoryCallerSession) PredictCertificateAddress(deployer common.Address, name string, symbol string, salt [32]byte) (common.Address, error) {
+	return _LBBCertFactory.Contract.PredictCertificateAddress(&_LBBCertFactory.CallOpts, deployer, name, symbol, salt)
+}
+
+// DeployCertificate is a paid mutator transaction binding the contract method 0xd5bce40d.
+//
+// Solidity: function deployCertificate(string name, string symbol, string baseURI, bytes32 salt) returns(address)
+func (_LBBCertFactory *LBBCertFactoryTransactor) DeployCertificate(opts *bind.TransactOpts, name string, symbol string, baseURI string, salt [32]byte) (*types.Transaction, error) {
+	return _LBBCertFactory.contract.Transact(opts, "deployCertificate", name, symbol, baseURI, salt)
+}
+
+// DeployCertificate is a paid mutator transaction binding the contract method 0xd5bce40d.
+//
+// Solidity: function deployCertificate(string name, string symbol, string baseURI, bytes32 salt) returns(address)
+func (_LBBCertFactory *LBBCertFactorySession) DeployCertificate(name string, symbol string, baseURI string, salt [32]byte) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.DeployCertificate(&_LBBCertFactory.TransactOpts, name, symbol, baseURI, salt)
+}
+
+// DeployCertificate is a paid mutator transaction binding the contract method 0xd5bce40d.
+//
+// Solidity: function deployCertificate(string name, string symbol, string baseURI, bytes32 salt) returns(address)
+func (_LBBCertFactory *LBBCertFactoryTransactorSession) DeployCertificate(name string, symbol string, baseURI string, salt [32]byte) (*types.Transaction, error) {
+	return _LBBCertFactory.Contract.DeployCertificate(&_LBBCertFactory.TransactOpts, name, symbol, baseURI, salt)
+}
+
+// LBBCertFactoryCertificateDeployedIterator is returned from FilterCertificateDeployed and is used to iterate over the raw logs and unpacked data for CertificateDeployed events raised by the LBBCertFactory contract.
+type LBBCertFactoryCertificateDeployedIterator struct {
+	Event *LBBCertFactoryCertificateDeployed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertFactoryCertificateDeployedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertFactoryCertificateDeployed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertFactoryCertificateDeployed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertFactoryCertificateDeployedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertFactoryCertificateDeployedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertFactoryCertificateDeployed represents a CertificateDeployed event raised by the LBBCertFactory contract.
+type LBBCertFactoryCertificateDeployed struct {
+	Certificate common.Address
+	Owner       common.Address
+	Salt        [32]byte
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterCertificateDeployed is a free log retrieval operation binding the contract event 0xdabb81d4853371da201c860b1ed47b41c68545e08fcad573249b63d3642423cc.
+//
+// Solidity: event CertificateDeployed(address indexed certificate, address indexed owner, bytes32 indexed salt)
+func (_LBBCertFactory *LBBCertFactoryFilterer) FilterCertificateDeployed(opts *bind.FilterOpts, certificate []common.Address, owner []common.Address, salt [][32]byte) (*LBBCertFactoryCertificateDeployedIterator, error) {
+
+	var certificateRule []interface{}
+	for _, certificateItem := range certificate {
+		certificateRule = append(certificateRule, certificateItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var saltRule []interface{}
+	for _, saltItem := range salt {
+		saltRule = append(saltRule, saltItem)
+	}
+
+	logs, sub, err := _LBBCertFactory.contract.FilterLogs(opts, "CertificateDeployed", certificateRule, ownerRule, saltRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFactoryCertificateDeployedIterator{contract: _LBBCertFactory.contract, event: "CertificateDeployed", logs: logs, sub: sub}, nil
+}
+
+// WatchCertificateDeployed is a free log subscription operation binding the contract event 0xdabb81d4853371da201c860b1ed47b41c68545e08fcad573249b63d3642423cc.
+//
+// Solidity: event CertificateDeployed(address indexed certificate, address indexed owner, bytes32 indexed salt)
+func (_LBBCertFactory *LBBCertFactoryFilterer) WatchCertificateDeployed(opts *bind.WatchOpts, sink chan<- *LBBCertFactoryCertificateDeployed, certificate []common.Address, owner []common.Address, salt [][32]byte) (event.Subscription, error) {
+
+	var certificateRule []interface{}
+	for _, certificateItem := range certificate {
+		certificateRule = append(certificateRule, certificateItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var saltRule []interface{}
+	for _, saltItem := range salt {
+		saltRule = append(saltRule, saltItem)
+	}
+
+	logs, sub, err := _LBBCertFactory.contract.WatchLogs(opts, "CertificateDeployed", certificateRule, ownerRule, saltRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertFactoryCertificateDeployed)
+				if err := _LBBCertFactory.contract.UnpackLog(event, "CertificateDeployed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCertificateDeployed is a log parse operation binding the contract event 0xdabb81d4853371da201c860b1ed47b41c68545e08fcad573249b63d3642423cc.
+//
+// Solidity: event CertificateDeployed(address indexed certificate, address indexed owner, bytes32 indexed salt)
+func (_LBBCertFactory *LBBCertFactoryFilterer) ParseCertificateDeployed(log types.Log) (*LBBCertFactoryCertificateDeployed, error) {
+	event := new(LBBCertFactoryCertificateDeployed)
+	if err := _LBBCertFactory.contract.UnpackLog(event, "CertificateDeployed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
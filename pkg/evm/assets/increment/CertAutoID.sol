@@ -34,7 +34,12 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
             "PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"
         );
 
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
     // EVENT
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
     event safeMintEvent(address to, uint256 tokenId);
     event PermitUsed(
         address indexed owner,
@@ -63,6 +68,16 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
         return tokenId;
     }
 
+    /**
+     * @dev Mints the next to.length token IDs, in order, to to[i] in one transaction and returns the first of them
+     */
+    function safeMintBatch(address[] calldata to) public onlyOwner returns (uint256 firstTokenId) {
+        firstTokenId = _nextTokenId;
+        for (uint256 i = 0; i < to.length; i++) {
+            _safeMint(to[i], _nextTokenId++);
+        }
+    }
+
     function nextTokenId() public view virtual returns (uint256) {
         return _nextTokenId;
     }
@@ -74,6 +89,22 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
 
     function setBaseURI(string calldata baseURI) external onlyOwner {
         _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
     }
 
     function tokenURI(
@@ -261,6 +292,6 @@ contract LBBCert is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712 {
     function supportsInterface(
         bytes4 interfaceId
     ) public view override(ERC721, ERC721Enumerable) returns (bool) {
-        return super.supportsInterface(interfaceId);
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
     }
 }
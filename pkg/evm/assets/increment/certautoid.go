@@ -0,0 +1,2239 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package increment
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CertAutoIDMetaData contains all meta data concerning the CertAutoID contract.
+var CertAutoIDMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"initialOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"burnWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nextTokenId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nonces\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"permit\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBaseURI\",\"inputs\":[{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenByIndex\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenOfOwnerByIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitForAllUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"safeMintEvent\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"ERC721EnumerableForbiddenBatchMint\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ERC721IncorrectOwner\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InsufficientApproval\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOperator\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721NonexistentToken\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721OutOfBoundsIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidShortString\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSigner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonExistentTokenURI\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SignatureExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"StringTooLong\",\"inputs\":[{\"name\":\"str\",\"type\":\"string\",\"internalType\":\"string\"}]}]",
+	Bin: "0x61016060405234801562000011575f80fd5b506040516200277c3803806200277c83398101604081905262000034916200030f565b6040805180820190915260018152603160f81b602082015284908282865f6200005e838262000447565b5060016200006d828262000447565b5050506001600160a01b0381166200009f57604051631e4fbdf760e01b81525f60048201526024015b60405180910390fd5b620000aa816200017e565b50620000b882600b620001cf565b61012052620000c981600c620001cf565b61014052815160208084019190912060e052815190820120610100524660a0526200015660e05161010051604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201529081019290925260608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b60805250503060c052600d6200016d838262000447565b50506001600e555062000567915050565b600a80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b5f602083511015620001ee57620001e68362000207565b905062000201565b81620001fb848262000447565b5060ff90505b92915050565b5f80829050601f8151111562000234578260405163305a27a960e01b81526004016200009691906200050f565b8051620002418262000543565b179392505050565b634e487b7160e01b5f52604160045260245ffd5b5f5b83811015620002795781810151838201526020016200025f565b50505f910152565b5f82601f83011262000291575f80fd5b81516001600160401b0380821115620002ae57620002ae62000249565b604051601f8301601f19908116603f01168101908282118183101715620002d957620002d962000249565b81604052838152866020858801011115620002f2575f80fd5b620003058460208301602089016200025d565b9695505050505050565b5f805f806080858703121562000323575f80fd5b84516001600160401b03808211156200033a575f80fd5b620003488883890162000281565b955060208701519150808211156200035e575f80fd5b6200036c8883890162000281565b9450604087015191508082111562000382575f80fd5b50620003918782880162000281565b606087015190935090506001600160a01b0381168114620003b0575f80fd5b939692955090935050565b600181811c90821680620003d057607f821691505b602082108103620003ef57634e487b7160e01b5f52602260045260245ffd5b50919050565b601f82111562000442575f81815260208120601f850160051c810160208610156200041d5750805b601f850160051c820191505b818110156200043e5782815560010162000429565b5050505b505050565b81516001600160401b0381111562000463576200046362000249565b6200047b81620004748454620003bb565b84620003f5565b602080601f831160018114620004b1575f8415620004995750858301515b5f19600386901b1c1916600185901b1785556200043e565b5f85815260208120601f198616915b82811015620004e157888601518255948401946001909101908401620004c0565b5085821015620004ff57878501515f19600388901b60f8161c191681555b5050505050600190811b01905550565b602081525f82518060208401526200052f8160408501602087016200025d565b601f01601f19169190910160400192915050565b80516020808301519190811015620003ef575f1960209190910360031b1b16919050565b60805160a05160c05160e0516101005161012051610140516121c3620005b95f395f610e5501525f610e2801525f610d3a01525f610d1201525f610c6d01525f610c9701525f610cc101526121c35ff3fe608060405234801561000f575f80fd5b50600436106101d1575f3560e01c806370a08231116100fe57806395d89b411161009e578063d505accf1161006e578063d505accf146103e2578063e985e9c5146103f5578063f2fde38b14610408578063f7dedf5e1461041b575f80fd5b806395d89b41146103a1578063a22cb465146103a9578063b88d4fde146103bc578063c87b56dd146103cf575f80fd5b80637ecebe00116100d95780637ecebe001461033a57806384b0196e146103625780638da5cb5b1461037d5780639032c7261461038e575f80fd5b806370a0823114610317578063715018a61461032a57806375794a3c14610332575f80fd5b80633644e515116101745780634f6ccce7116101445780634f6ccce7146102cb57806355f804b3146102de578063605629d6146102f15780636352211e14610304575f80fd5b80633644e5151461028a57806340d097c31461029257806342842e0e146102a557806342966c68146102b8575f80fd5b8063095ea7b3116101af578063095ea7b31461023d57806318160ddd1461025257806323b872dd146102645780632f745c5914610277575f80fd5b806301ffc9a7146101d557806306fdde03146101fd578063081812fc14610212575b5f80fd5b6101e86101e3366004611a6e565b61042e565b60405190151581526020015b60405180910390f35b61020561043e565b6040516101f49190611add565b610225610220366004611aef565b6104cd565b6040516001600160a01b0390911681526020016101f4565b61025061024b366004611b1c565b6104f4565b005b6008545b6040519081526020016101f4565b610250610272366004611b44565b610503565b610256610285366004611b1c565b610591565b6102566105f4565b6102566102a0366004611b7d565b610602565b6102506102b3366004611b44565b610633565b6102506102c6366004611aef565b610652565b6102566102d9366004611aef565b61065d565b6102506102ec366004611b96565b6106b2565b6102506102ff366004611c12565b6106c7565b610225610312366004611aef565b6106ea565b610256610325366004611b7d565b6106f4565b610250610739565b600e54610256565b610256610348366004611b7d565b6001600160a01b03165f908152600f602052604090205490565b61036a61074c565b6040516101f49796959493929190611c77565b600a546001600160a01b0316610225565b61025061039c366004611d1a565b61078e565b610205610904565b6102506103b7366004611d6a565b610913565b6102506103ca366004611daf565b61091e565b6102056103dd366004611aef565b610935565b6102506103f0366004611c12565b6109c2565b6101e8610403366004611e84565b610b5a565b610250610416366004611b7d565b610b87565b610250610429366004611eac565b610bc4565b5f61043882610be4565b92915050565b60605f805461044c90611f00565b80601f016020809104026020016040519081016040528092919081815260200182805461047890611f00565b80156104c35780601f1061049a576101008083540402835291602001916104c3565b820191905f5260205f20905b8154815290600101906020018083116104a657829003601f168201915b5050505050905090565b5f6104d782610c08565b505f828152600460205260409020546001600160a01b0316610438565b6104ff828233610c40565b5050565b6001600160a01b03821661053157604051633250574960e11b81525f60048201526024015b60405180910390fd5b5f61053d838333610c4d565b9050836001600160a01b0316816001600160a01b03161461058b576040516364283d7b60e01b81526001600160a01b0380861660048301526024820184905282166044820152606401610528565b50505050565b5f61059b836106f4565b82106105cc5760405163295f44f760e21b81526001600160a01b038416600482015260248101839052604401610528565b506001600160a01b03919091165f908152600660209081526040808320938352929052205490565b5f6105fd610c61565b905090565b5f61060b610d8a565b600e80545f918261061b83611f4c565b91905055905061062b8382610db7565b90505b919050565b61064d83838360405180602001604052805f81525061091e565b505050565b6104ff5f8233610c4d565b5f61066760085490565b821061068f5760405163295f44f760e21b81525f600482015260248101839052604401610528565b600882815481106106a2576106a2611f64565b905f5260205f2001549050919050565b6106ba610d8a565b600d61064d828483611fbd565b6106d6873387878787876109c2565b6106e1878787610633565b50505050505050565b5f61043882610c08565b5f6001600160a01b03821661071e576040516322718ad960e21b81525f6004820152602401610528565b506001600160a01b03165f9081526003602052604090205490565b610741610d8a565b61074a5f610dd0565b565b5f6060805f805f606061075d610e21565b610765610e4e565b604080515f80825260208201909252600f60f81b9b939a50919850469750309650945092509050565b834211156107af57604051630819bdcd60e01b815260040160405180910390fd5b6001600160a01b0387165f908152600f6020526040812080547f47ab88482c90e4bb94b82a947ae78fa91fb25de1469ab491f4c15b9a0a2677ee918a918a918a9190866107fb83611f4c565b909155506040805160208101969096526001600160a01b03948516908601529290911660608401521515608083015260a082015260c0810186905260e0016040516020818303038152906040528051906020012090505f61085b82610e7b565b90505f61086a82878787610ea7565b9050896001600160a01b0316816001600160a01b03161461089e57604051632057875960e21b815260040160405180910390fd5b6108a98a8a8a610ed3565b886001600160a01b03168a6001600160a01b03167f03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b04778a6040516108f0911515815260200190565b60405180910390a350505050505050505050565b60606001805461044c90611f00565b6104ff338383610ed3565b610929848484610503565b61058b84848484610f71565b60605f610941836106ea565b6001600160a01b0316036109685760405163d872946b60e01b815260040160405180910390fd5b5f600d805461097690611f00565b9050116109915760405180602001604052805f815250610438565b600d61099c83611097565b6040516020016109ad929190612078565b60405160208183030381529060405292915050565b834211156109e357604051630819bdcd60e01b815260040160405180910390fd5b6001600160a01b0387165f908152600f6020526040812080547f48d39b37a35214940203bbbd4f383519797769b13d936f387d89430afef27688918a918a918a919086610a2f83611f4c565b909155506040805160208101969096526001600160a01b0394851690860152929091166060840152608083015260a082015260c0810186905260e0016040516020818303038152906040528051906020012090505f610a8d82610e7b565b90505f610a9c82878787610ea7565b9050896001600160a01b0316816001600160a01b031614610ad057604051632057875960e21b815260040160405180910390fd5b896001600160a01b0316610ae3896106ea565b6001600160a01b031614610b0a57604051632057875960e21b815260040160405180910390fd5b610b1589898c610c40565b886001600160a01b03168a6001600160a01b03167f1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd43268a6040516108f091815260200190565b6001600160a01b039182165f90815260056020908152604080832093909416825291909152205460ff1690565b610b8f610d8a565b6001600160a01b038116610bb857604051631e4fbdf760e01b81525f6004820152602401610528565b610bc181610dd0565b50565b610bd3863387878787876109c2565b610bdc85610652565b505050505050565b5f6001600160e01b0319821663780e9d6360e01b1480610438575061043882611127565b5f818152600260205260408120546001600160a01b03168061062b57604051637e27328960e01b815260048101849052602401610528565b61064d8383836001611176565b5f610c5984848461127a565b949350505050565b5f306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016148015610cb957507f000000000000000000000000000000000000000000000000000000000000000046145b15610ce357507f000000000000000000000000000000000000000000000000000000000000000090565b6105fd604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201527f0000000000000000000000000000000000000000000000000000000000000000918101919091527f000000000000000000000000000000000000000000000000000000000000000060608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b600a546001600160a01b0316331461074a5760405163118cdaa760e01b8152336004820152602401610528565b6104ff828260405180602001604052805f815250611345565b600a80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b60606105fd7f0000000000000000000000000000000000000000000000000000000000000000600b61135b565b60606105fd7f0000000000000000000000000000000000000000000000000000000000000000600c61135b565b5f610438610e87610c61565b8360405161190160f01b8152600281019290925260228201526042902090565b5f805f80610eb788888888611404565b925092509250610ec782826114cc565b50909695505050505050565b6001600160a01b038216610f0557604051630b61174360e31b81526001600160a01b0383166004820152602401610528565b6001600160a01b038381165f81815260056020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6001600160a01b0383163b1561058b57604051630a85bd0160e11b81526001600160a01b0384169063150b7a0290610fb39033908890879087906004016120fb565b6020604051808303815f875af1925050508015610fed575060408051601f3d908101601f19168201909252610fea91810190612137565b60015b611054573d80801561101a576040519150601f19603f3d011682016040523d82523d5f602084013e61101f565b606091505b5080515f0361104c57604051633250574960e11b81526001600160a01b0385166004820152602401610528565b805181602001fd5b6001600160e01b03198116630a85bd0160e11b1461109057604051633250574960e11b81526001600160a01b0385166004820152602401610528565b5050505050565b60605f6110a383611584565b60010190505f8167ffffffffffffffff8111156110c2576110c2611d9b565b6040519080825280601f01601f1916602001820160405280156110ec576020820181803683370190505b5090508181016020015b5f19016f181899199a1a9b1b9c1cb0b131b232b360811b600a86061a8153600a85049450846110f657509392505050565b5f6001600160e01b031982166380ac58cd60e01b148061115757506001600160e01b03198216635b5e139f60e01b145b8061043857506301ffc9a760e01b6001600160e01b0319831614610438565b808061118a57506001600160a01b03821615155b1561124b575f61119984610c08565b90506001600160a01b038316158015906111c55750826001600160a01b0316816001600160a01b031614155b80156111d857506111d68184610b5a565b155b156112015760405163a9fbf51f60e01b81526001600160a01b0384166004820152602401610528565b81156112495783856001600160a01b0316826001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45b505b50505f90815260046020526040902080546001600160a01b0319166001600160a01b0392909216919091179055565b5f8061128785858561165b565b90506001600160a01b0381166112e3576112de84600880545f838152600960205260408120829055600182018355919091527ff3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee30155565b611306565b846001600160a01b0316816001600160a01b03161461130657611306818561174d565b6001600160a01b0385166113225761131d846117da565b610c59565b846001600160a01b0316816001600160a01b031614610c5957610c598585611881565b61134f83836118cf565b61064d5f848484610f71565b606060ff83146113755761136e83611930565b9050610438565b81805461138190611f00565b80601f01602080910402602001604051908101604052809291908181526020018280546113ad90611f00565b80156113f85780601f106113cf576101008083540402835291602001916113f8565b820191905f5260205f20905b8154815290600101906020018083116113db57829003601f168201915b50505050509050610438565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a084111561143d57505f915060039050826114c2565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa15801561148e573d5f803e3d5ffd5b5050604051601f1901519150506001600160a01b0381166114b957505f9250600191508290506114c2565b92505f91508190505b9450945094915050565b5f8260038111156114df576114df612152565b036114e8575050565b60018260038111156114fc576114fc612152565b0361151a5760405163f645eedf60e01b815260040160405180910390fd5b600282600381111561152e5761152e612152565b0361154f5760405163fce698f760e01b815260048101829052602401610528565b600382600381111561156357611563612152565b036104ff576040516335e2f38360e21b815260048101829052602401610528565b5f8072184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b83106115c25772184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b830492506040015b6d04ee2d6d415b85acef810000000083106115ee576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc10000831061160c57662386f26fc10000830492506010015b6305f5e1008310611624576305f5e100830492506008015b612710831061163857612710830492506004015b6064831061164a576064830492506002015b600a831061062b5760010192915050565b5f828152600260205260408120546001600160a01b03908116908316156116875761168781848661196d565b6001600160a01b038116156116c1576116a25f855f80611176565b6001600160a01b0381165f90815260036020526040902080545f190190555b6001600160a01b038516156116ef576001600160a01b0385165f908152600360205260409020805460010190555b5f8481526002602052604080822080546001600160a01b0319166001600160a01b0389811691821790925591518793918516917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4949350505050565b5f611757836106f4565b5f838152600760205260409020549091508082146117a8576001600160a01b0384165f9081526006602090815260408083208584528252808320548484528184208190558352600790915290208190555b505f9182526007602090815260408084208490556001600160a01b039094168352600681528383209183525290812055565b6008545f906117eb90600190612166565b5f838152600960205260408120546008805493945090928490811061181257611812611f64565b905f5260205f2001549050806008838154811061183157611831611f64565b5f91825260208083209091019290925582815260099091526040808220849055858252812055600880548061186857611868612179565b600190038181905f5260205f20015f9055905550505050565b5f600161188d846106f4565b6118979190612166565b6001600160a01b039093165f908152600660209081526040808320868452825280832085905593825260079052919091209190915550565b6001600160a01b0382166118f857604051633250574960e11b81525f6004820152602401610528565b5f61190483835f610c4d565b90506001600160a01b0381161561064d576040516339e3563760e11b81525f6004820152602401610528565b60605f61193c836119d1565b6040805160208082528183019092529192505f91906020820181803683375050509182525060208101929092525090565b6119788383836119f8565b61064d576001600160a01b0383166119a657604051637e27328960e01b815260048101829052602401610528565b60405163177e802f60e01b81526001600160a01b038316600482015260248101829052604401610528565b5f60ff8216601f81111561062b57604051632cd44ac360e21b815260040160405180910390fd5b5f6001600160a01b03831615801590610c595750826001600160a01b0316846001600160a01b03161480611a315750611a318484610b5a565b80610c595750505f908152600460205260409020546001600160a01b03908116911614919050565b6001600160e01b031981168114610bc1575f80fd5b5f60208284031215611a7e575f80fd5b8135611a8981611a59565b9392505050565b5f5b83811015611aaa578181015183820152602001611a92565b50505f910152565b5f8151808452611ac9816020860160208601611a90565b601f01601f19169290920160200192915050565b602081525f611a896020830184611ab2565b5f60208284031215611aff575f80fd5b5035919050565b80356001600160a01b038116811461062e575f80fd5b5f8060408385031215611b2d575f80fd5b611b3683611b06565b946020939093013593505050565b5f805f60608486031215611b56575f80fd5b611b5f84611b06565b9250611b6d60208501611b06565b9150604084013590509250925092565b5f60208284031215611b8d575f80fd5b611a8982611b06565b5f8060208385031215611ba7575f80fd5b823567ffffffffffffffff80821115611bbe575f80fd5b818501915085601f830112611bd1575f80fd5b813581811115611bdf575f80fd5b866020828501011115611bf0575f80fd5b60209290920196919550909350505050565b803560ff8116811461062e575f80fd5b5f805f805f805f60e0888a031215611c28575f80fd5b611c3188611b06565b9650611c3f60208901611b06565b95506040880135945060608801359350611c5b60808901611c02565b925060a0880135915060c0880135905092959891949750929550565b60ff60f81b881681525f602060e081840152611c9660e084018a611ab2565b8381036040850152611ca8818a611ab2565b606085018990526001600160a01b038816608086015260a0850187905284810360c086015285518082528387019250908301905f5b81811015611cf957835183529284019291840191600101611cdd565b50909c9b505050505050505050505050565b8035801515811461062e575f80fd5b5f805f805f805f60e0888a031215611d30575f80fd5b611d3988611b06565b9650611d4760208901611b06565b9550611d5560408901611d0b565b945060608801359350611c5b60808901611c02565b5f8060408385031215611d7b575f80fd5b611d8483611b06565b9150611d9260208401611d0b565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f805f8060808587031215611dc2575f80fd5b611dcb85611b06565b9350611dd960208601611b06565b925060408501359150606085013567ffffffffffffffff80821115611dfc575f80fd5b818701915087601f830112611e0f575f80fd5b813581811115611e2157611e21611d9b565b604051601f8201601f19908116603f01168101908382118183101715611e4957611e49611d9b565b816040528281528a6020848701011115611e61575f80fd5b826020860160208301375f60208483010152809550505050505092959194509250565b5f8060408385031215611e95575f80fd5b611e9e83611b06565b9150611d9260208401611b06565b5f805f805f8060c08789031215611ec1575f80fd5b611eca87611b06565b95506020870135945060408701359350611ee660608801611c02565b92506080870135915060a087013590509295509295509295565b600181811c90821680611f1457607f821691505b602082108103611f3257634e487b7160e01b5f52602260045260245ffd5b50919050565b634e487b7160e01b5f52601160045260245ffd5b5f60018201611f5d57611f5d611f38565b5060010190565b634e487b7160e01b5f52603260045260245ffd5b601f82111561064d575f81815260208120601f850160051c81016020861015611f9e5750805b601f850160051c820191505b81811015610bdc57828155600101611faa565b67ffffffffffffffff831115611fd557611fd5611d9b565b611fe983611fe38354611f00565b83611f78565b5f601f84116001811461201a575f85156120035750838201355b5f19600387901b1c1916600186901b178355611090565b5f83815260209020601f19861690835b8281101561204a578685013582556020948501946001909201910161202a565b5086821015612066575f1960f88860031b161c19848701351681555b505060018560011b0183555050505050565b5f80845461208581611f00565b6001828116801561209d57600181146120b2576120de565b60ff19841687528215158302870194506120de565b885f526020805f205f5b858110156120d55781548a8201529084019082016120bc565b50505082870194505b5050505083516120f2818360208801611a90565b01949350505050565b6001600160a01b03858116825284166020820152604081018390526080606082018190525f9061212d90830184611ab2565b9695505050505050565b5f60208284031215612147575f80fd5b8151611a8981611a59565b634e487b7160e01b5f52602160045260245ffd5b8181038181111561043857610438611f38565b634e487b7160e01b5f52603160045260245ffdfea2646970667358221220dd3eebeaca229a65cf4d0f620b6db4bc8499a14c73bae1b570489a9782eb4f0a64736f6c63430008140033",
+}
+
+// CertAutoIDABI is the input ABI used to generate the binding from.
+// Deprecated: Use CertAutoIDMetaData.ABI instead.
+var CertAutoIDABI = CertAutoIDMetaData.ABI
+
+// CertAutoIDBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CertAutoIDMetaData.Bin instead.
+var CertAutoIDBin = CertAutoIDMetaData.Bin
+
+// DeployCertAutoID deploys a new Ethereum contract, binding an instance of CertAutoID to it.
+func DeployCertAutoID(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, baseURI string, initialOwner common.Address) (common.Address, *types.Transaction, *CertAutoID, error) {
+	parsed, err := CertAutoIDMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CertAutoIDBin), backend, name, symbol, baseURI, initialOwner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CertAutoID{CertAutoIDCaller: CertAutoIDCaller{contract: contract}, CertAutoIDTransactor: CertAutoIDTransactor{contract: contract}, CertAutoIDFilterer: CertAutoIDFilterer{contract: contract}}, nil
+}
+
+// CertAutoID is an auto generated Go binding around an Ethereum contract.
+type CertAutoID struct {
+	CertAutoIDCaller     // Read-only binding to the contract
+	CertAutoIDTransactor // Write-only binding to the contract
+	CertAutoIDFilterer   // Log filterer for contract events
+}
+
+// CertAutoIDCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CertAutoIDCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CertAutoIDTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CertAutoIDTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CertAutoIDFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CertAutoIDFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CertAutoIDSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CertAutoIDSession struct {
+	Contract     *CertAutoID       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CertAutoIDCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CertAutoIDCallerSession struct {
+	Contract *CertAutoIDCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// CertAutoIDTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CertAutoIDTransactorSession struct {
+	Contract     *CertAutoIDTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// CertAutoIDRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CertAutoIDRaw struct {
+	Contract *CertAutoID // Generic contract binding to access the raw methods on
+}
+
+// CertAutoIDCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CertAutoIDCallerRaw struct {
+	Contract *CertAutoIDCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CertAutoIDTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CertAutoIDTransactorRaw struct {
+	Contract *CertAutoIDTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCertAutoID creates a new instance of CertAutoID, bound to a specific deployed contract.
+func NewCertAutoID(address common.Address, backend bind.ContractBackend) (*CertAutoID, error) {
+	contract, err := bindCertAutoID(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoID{CertAutoIDCaller: CertAutoIDCaller{contract: contract}, CertAutoIDTransactor: CertAutoIDTransactor{contract: contract}, CertAutoIDFilterer: CertAutoIDFilterer{contract: contract}}, nil
+}
+
+// NewCertAutoIDCaller creates a new read-only instance of CertAutoID, bound to a specific deployed contract.
+func NewCertAutoIDCaller(address common.Address, caller bind.ContractCaller) (*CertAutoIDCaller, error) {
+	contract, err := bindCertAutoID(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDCaller{contract: contract}, nil
+}
+
+// NewCertAutoIDTransactor creates a new write-only instance of CertAutoID, bound to a specific deployed contract.
+func NewCertAutoIDTransactor(address common.Address, transactor bind.ContractTransactor) (*CertAutoIDTransactor, error) {
+	contract, err := bindCertAutoID(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDTransactor{contract: contract}, nil
+}
+
+// NewCertAutoIDFilterer creates a new log filterer instance of CertAutoID, bound to a specific deployed contract.
+func NewCertAutoIDFilterer(address common.Address, filterer bind.ContractFilterer) (*CertAutoIDFilterer, error) {
+	contract, err := bindCertAutoID(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDFilterer{contract: contract}, nil
+}
+
+// bindCertAutoID binds a generic wrapper to an already deployed contract.
+func bindCertAutoID(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CertAutoIDMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CertAutoID *CertAutoIDRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CertAutoID.Contract.CertAutoIDCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CertAutoID *CertAutoIDRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CertAutoID.Contract.CertAutoIDTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CertAutoID *CertAutoIDRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CertAutoID.Contract.CertAutoIDTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CertAutoID *CertAutoIDCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CertAutoID.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CertAutoID *CertAutoIDTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CertAutoID.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CertAutoID *CertAutoIDTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CertAutoID.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_CertAutoID *CertAutoIDCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_CertAutoID *CertAutoIDSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _CertAutoID.Contract.DOMAINSEPARATOR(&_CertAutoID.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_CertAutoID *CertAutoIDCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _CertAutoID.Contract.DOMAINSEPARATOR(&_CertAutoID.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _CertAutoID.Contract.BalanceOf(&_CertAutoID.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _CertAutoID.Contract.BalanceOf(&_CertAutoID.CallOpts, owner)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_CertAutoID *CertAutoIDCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_CertAutoID *CertAutoIDSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _CertAutoID.Contract.Eip712Domain(&_CertAutoID.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_CertAutoID *CertAutoIDCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _CertAutoID.Contract.Eip712Domain(&_CertAutoID.CallOpts)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _CertAutoID.Contract.GetApproved(&_CertAutoID.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _CertAutoID.Contract.GetApproved(&_CertAutoID.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CertAutoID *CertAutoIDCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CertAutoID *CertAutoIDSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _CertAutoID.Contract.IsApprovedForAll(&_CertAutoID.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_CertAutoID *CertAutoIDCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _CertAutoID.Contract.IsApprovedForAll(&_CertAutoID.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_CertAutoID *CertAutoIDCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_CertAutoID *CertAutoIDSession) Name() (string, error) {
+	return _CertAutoID.Contract.Name(&_CertAutoID.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_CertAutoID *CertAutoIDCallerSession) Name() (string, error) {
+	return _CertAutoID.Contract.Name(&_CertAutoID.CallOpts)
+}
+
+// NextTokenId is a free data retrieval call binding the contract method 0x75794a3c.
+//
+// Solidity: function nextTokenId() view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) NextTokenId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "nextTokenId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NextTokenId is a free data retrieval call binding the contract method 0x75794a3c.
+//
+// Solidity: function nextTokenId() view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) NextTokenId() (*big.Int, error) {
+	return _CertAutoID.Contract.NextTokenId(&_CertAutoID.CallOpts)
+}
+
+// NextTokenId is a free data retrieval call binding the contract method 0x75794a3c.
+//
+// Solidity: function nextTokenId() view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) NextTokenId() (*big.Int, error) {
+	return _CertAutoID.Contract.NextTokenId(&_CertAutoID.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _CertAutoID.Contract.Nonces(&_CertAutoID.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _CertAutoID.Contract.Nonces(&_CertAutoID.CallOpts, owner)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CertAutoID *CertAutoIDCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CertAutoID *CertAutoIDSession) Owner() (common.Address, error) {
+	return _CertAutoID.Contract.Owner(&_CertAutoID.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CertAutoID *CertAutoIDCallerSession) Owner() (common.Address, error) {
+	return _CertAutoID.Contract.Owner(&_CertAutoID.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _CertAutoID.Contract.OwnerOf(&_CertAutoID.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_CertAutoID *CertAutoIDCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _CertAutoID.Contract.OwnerOf(&_CertAutoID.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CertAutoID *CertAutoIDCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CertAutoID *CertAutoIDSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CertAutoID.Contract.SupportsInterface(&_CertAutoID.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_CertAutoID *CertAutoIDCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _CertAutoID.Contract.SupportsInterface(&_CertAutoID.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CertAutoID *CertAutoIDCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CertAutoID *CertAutoIDSession) Symbol() (string, error) {
+	return _CertAutoID.Contract.Symbol(&_CertAutoID.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_CertAutoID *CertAutoIDCallerSession) Symbol() (string, error) {
+	return _CertAutoID.Contract.Symbol(&_CertAutoID.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _CertAutoID.Contract.TokenByIndex(&_CertAutoID.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _CertAutoID.Contract.TokenByIndex(&_CertAutoID.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _CertAutoID.Contract.TokenOfOwnerByIndex(&_CertAutoID.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _CertAutoID.Contract.TokenOfOwnerByIndex(&_CertAutoID.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_CertAutoID *CertAutoIDCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_CertAutoID *CertAutoIDSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _CertAutoID.Contract.TokenURI(&_CertAutoID.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_CertAutoID *CertAutoIDCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _CertAutoID.Contract.TokenURI(&_CertAutoID.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_CertAutoID *CertAutoIDCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CertAutoID.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_CertAutoID *CertAutoIDSession) TotalSupply() (*big.Int, error) {
+	return _CertAutoID.Contract.TotalSupply(&_CertAutoID.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_CertAutoID *CertAutoIDCallerSession) TotalSupply() (*big.Int, error) {
+	return _CertAutoID.Contract.TotalSupply(&_CertAutoID.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Approve(&_CertAutoID.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Approve(&_CertAutoID.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Burn(&_CertAutoID.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Burn(&_CertAutoID.TransactOpts, tokenId)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactor) BurnWithPermit(opts *bind.TransactOpts, from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "burnWithPermit", from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDSession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.BurnWithPermit(&_CertAutoID.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.BurnWithPermit(&_CertAutoID.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "permit", owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Permit(&_CertAutoID.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.Permit(&_CertAutoID.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactor) PermitForAll(opts *bind.TransactOpts, owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "permitForAll", owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.PermitForAll(&_CertAutoID.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.PermitForAll(&_CertAutoID.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CertAutoID *CertAutoIDTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CertAutoID *CertAutoIDSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CertAutoID.Contract.RenounceOwnership(&_CertAutoID.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CertAutoID *CertAutoIDTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CertAutoID.Contract.RenounceOwnership(&_CertAutoID.TransactOpts)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256)
+func (_CertAutoID *CertAutoIDTransactor) SafeMint(opts *bind.TransactOpts, to common.Address) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "safeMint", to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256)
+func (_CertAutoID *CertAutoIDSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeMint(&_CertAutoID.TransactOpts, to)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0x40d097c3.
+//
+// Solidity: function safeMint(address to) returns(uint256)
+func (_CertAutoID *CertAutoIDTransactorSession) SafeMint(to common.Address) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeMint(&_CertAutoID.TransactOpts, to)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeTransferFrom(&_CertAutoID.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeTransferFrom(&_CertAutoID.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CertAutoID *CertAutoIDTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CertAutoID *CertAutoIDSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeTransferFrom0(&_CertAutoID.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SafeTransferFrom0(&_CertAutoID.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_CertAutoID *CertAutoIDTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_CertAutoID *CertAutoIDSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SetApprovalForAll(&_CertAutoID.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SetApprovalForAll(&_CertAutoID.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_CertAutoID *CertAutoIDTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI string) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "setBaseURI", baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_CertAutoID *CertAutoIDSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SetBaseURI(&_CertAutoID.TransactOpts, baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _CertAutoID.Contract.SetBaseURI(&_CertAutoID.TransactOpts, baseURI)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferFrom(&_CertAutoID.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferFrom(&_CertAutoID.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CertAutoID *CertAutoIDTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CertAutoID *CertAutoIDSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferOwnership(&_CertAutoID.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferOwnership(&_CertAutoID.TransactOpts, newOwner)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactor) TransferWithPermit(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.contract.Transact(opts, "transferWithPermit", from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDSession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferWithPermit(&_CertAutoID.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_CertAutoID *CertAutoIDTransactorSession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _CertAutoID.Contract.TransferWithPermit(&_CertAutoID.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// CertAutoIDApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the CertAutoID contract.
+type CertAutoIDApprovalIterator struct {
+	Event *CertAutoIDApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDApproval represents a Approval event raised by the CertAutoID contract.
+type CertAutoIDApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*CertAutoIDApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDApprovalIterator{contract: _CertAutoID.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *CertAutoIDApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDApproval)
+				if err := _CertAutoID.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) ParseApproval(log types.Log) (*CertAutoIDApproval, error) {
+	event := new(CertAutoIDApproval)
+	if err := _CertAutoID.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the CertAutoID contract.
+type CertAutoIDApprovalForAllIterator struct {
+	Event *CertAutoIDApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDApprovalForAll represents a ApprovalForAll event raised by the CertAutoID contract.
+type CertAutoIDApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*CertAutoIDApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDApprovalForAllIterator{contract: _CertAutoID.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *CertAutoIDApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDApprovalForAll)
+				if err := _CertAutoID.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) ParseApprovalForAll(log types.Log) (*CertAutoIDApprovalForAll, error) {
+	event := new(CertAutoIDApprovalForAll)
+	if err := _CertAutoID.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the CertAutoID contract.
+type CertAutoIDEIP712DomainChangedIterator struct {
+	Event *CertAutoIDEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDEIP712DomainChanged represents a EIP712DomainChanged event raised by the CertAutoID contract.
+type CertAutoIDEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_CertAutoID *CertAutoIDFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*CertAutoIDEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDEIP712DomainChangedIterator{contract: _CertAutoID.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_CertAutoID *CertAutoIDFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *CertAutoIDEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDEIP712DomainChanged)
+				if err := _CertAutoID.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_CertAutoID *CertAutoIDFilterer) ParseEIP712DomainChanged(log types.Log) (*CertAutoIDEIP712DomainChanged, error) {
+	event := new(CertAutoIDEIP712DomainChanged)
+	if err := _CertAutoID.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the CertAutoID contract.
+type CertAutoIDOwnershipTransferredIterator struct {
+	Event *CertAutoIDOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDOwnershipTransferred represents a OwnershipTransferred event raised by the CertAutoID contract.
+type CertAutoIDOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CertAutoID *CertAutoIDFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CertAutoIDOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDOwnershipTransferredIterator{contract: _CertAutoID.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CertAutoID *CertAutoIDFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CertAutoIDOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDOwnershipTransferred)
+				if err := _CertAutoID.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CertAutoID *CertAutoIDFilterer) ParseOwnershipTransferred(log types.Log) (*CertAutoIDOwnershipTransferred, error) {
+	event := new(CertAutoIDOwnershipTransferred)
+	if err := _CertAutoID.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDPermitForAllUsedIterator is returned from FilterPermitForAllUsed and is used to iterate over the raw logs and unpacked data for PermitForAllUsed events raised by the CertAutoID contract.
+type CertAutoIDPermitForAllUsedIterator struct {
+	Event *CertAutoIDPermitForAllUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDPermitForAllUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDPermitForAllUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDPermitForAllUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDPermitForAllUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDPermitForAllUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDPermitForAllUsed represents a PermitForAllUsed event raised by the CertAutoID contract.
+type CertAutoIDPermitForAllUsed struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitForAllUsed is a free log retrieval operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) FilterPermitForAllUsed(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*CertAutoIDPermitForAllUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDPermitForAllUsedIterator{contract: _CertAutoID.contract, event: "PermitForAllUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitForAllUsed is a free log subscription operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) WatchPermitForAllUsed(opts *bind.WatchOpts, sink chan<- *CertAutoIDPermitForAllUsed, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDPermitForAllUsed)
+				if err := _CertAutoID.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitForAllUsed is a log parse operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_CertAutoID *CertAutoIDFilterer) ParsePermitForAllUsed(log types.Log) (*CertAutoIDPermitForAllUsed, error) {
+	event := new(CertAutoIDPermitForAllUsed)
+	if err := _CertAutoID.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDPermitUsedIterator is returned from FilterPermitUsed and is used to iterate over the raw logs and unpacked data for PermitUsed events raised by the CertAutoID contract.
+type CertAutoIDPermitUsedIterator struct {
+	Event *CertAutoIDPermitUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDPermitUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDPermitUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDPermitUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDPermitUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDPermitUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDPermitUsed represents a PermitUsed event raised by the CertAutoID contract.
+type CertAutoIDPermitUsed struct {
+	Owner   common.Address
+	Spender common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitUsed is a free log retrieval operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) FilterPermitUsed(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*CertAutoIDPermitUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDPermitUsedIterator{contract: _CertAutoID.contract, event: "PermitUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitUsed is a free log subscription operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) WatchPermitUsed(opts *bind.WatchOpts, sink chan<- *CertAutoIDPermitUsed, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDPermitUsed)
+				if err := _CertAutoID.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitUsed is a log parse operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) ParsePermitUsed(log types.Log) (*CertAutoIDPermitUsed, error) {
+	event := new(CertAutoIDPermitUsed)
+	if err := _CertAutoID.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the CertAutoID contract.
+type CertAutoIDTransferIterator struct {
+	Event *CertAutoIDTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDTransfer represents a Transfer event raised by the CertAutoID contract.
+type CertAutoIDTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*CertAutoIDTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDTransferIterator{contract: _CertAutoID.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *CertAutoIDTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDTransfer)
+				if err := _CertAutoID.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_CertAutoID *CertAutoIDFilterer) ParseTransfer(log types.Log) (*CertAutoIDTransfer, error) {
+	event := new(CertAutoIDTransfer)
+	if err := _CertAutoID.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CertAutoIDSafeMintEventIterator is returned from FilterSafeMintEvent and is used to iterate over the raw logs and unpacked data for SafeMintEvent events raised by the CertAutoID contract.
+type CertAutoIDSafeMintEventIterator struct {
+	Event *CertAutoIDSafeMintEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CertAutoIDSafeMintEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CertAutoIDSafeMintEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CertAutoIDSafeMintEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CertAutoIDSafeMintEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CertAutoIDSafeMintEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CertAutoIDSafeMintEvent represents a SafeMintEvent event raised by the CertAutoID contract.
+type CertAutoIDSafeMintEvent struct {
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeMintEvent is a free log retrieval operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) FilterSafeMintEvent(opts *bind.FilterOpts) (*CertAutoIDSafeMintEventIterator, error) {
+
+	logs, sub, err := _CertAutoID.contract.FilterLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &CertAutoIDSafeMintEventIterator{contract: _CertAutoID.contract, event: "safeMintEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeMintEvent is a free log subscription operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) WatchSafeMintEvent(opts *bind.WatchOpts, sink chan<- *CertAutoIDSafeMintEvent) (event.Subscription, error) {
+
+	logs, sub, err := _CertAutoID.contract.WatchLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CertAutoIDSafeMintEvent)
+				if err := _CertAutoID.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeMintEvent is a log parse operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_CertAutoID *CertAutoIDFilterer) ParseSafeMintEvent(log types.Log) (*CertAutoIDSafeMintEvent, error) {
+	event := new(CertAutoIDSafeMintEvent)
+	if err := _CertAutoID.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -1,5 +1,7 @@
 package increment
 
+//go:generate abigen --abi contract.abi --bin contract.bin --pkg increment --type CertAutoID --out certautoid.go
+
 import (
 	"embed"
 	"fmt"
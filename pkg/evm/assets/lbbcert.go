@@ -0,0 +1,2208 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package assets
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LBBCertMetaData contains all meta data concerning the LBBCert contract.
+var LBBCertMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"initialOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"burnWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nonces\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"permit\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBaseURI\",\"inputs\":[{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenByIndex\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenOfOwnerByIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitForAllUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"safeMintEvent\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"ERC721EnumerableForbiddenBatchMint\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ERC721IncorrectOwner\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InsufficientApproval\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOperator\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721NonexistentToken\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721OutOfBoundsIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidShortString\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSigner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonExistentTokenURI\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SignatureExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"StringTooLong\",\"inputs\":[{\"name\":\"str\",\"type\":\"string\",\"internalType\":\"string\"}]}]",
+	Bin: "0x61016060405234801562000011575f80fd5b506040516200274938038062002749833981016040819052620000349162000309565b6040805180820190915260018152603160f81b602082015284908282865f6200005e838262000441565b5060016200006d828262000441565b5050506001600160a01b0381166200009f57604051631e4fbdf760e01b81525f60048201526024015b60405180910390fd5b620000aa8162000178565b50620000b882600b620001c9565b61012052620000c981600c620001c9565b61014052815160208084019190912060e052815190820120610100524660a0526200015660e05161010051604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201529081019290925260608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b60805250503060c052600d6200016d838262000441565b505050505062000561565b600a80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b5f602083511015620001e857620001e08362000201565b9050620001fb565b81620001f5848262000441565b5060ff90505b92915050565b5f80829050601f815111156200022e578260405163305a27a960e01b815260040162000096919062000509565b80516200023b826200053d565b179392505050565b634e487b7160e01b5f52604160045260245ffd5b5f5b838110156200027357818101518382015260200162000259565b50505f910152565b5f82601f8301126200028b575f80fd5b81516001600160401b0380821115620002a857620002a862000243565b604051601f8301601f19908116603f01168101908282118183101715620002d357620002d362000243565b81604052838152866020858801011115620002ec575f80fd5b620002ff84602083016020890162000257565b9695505050505050565b5f805f80608085870312156200031d575f80fd5b84516001600160401b038082111562000334575f80fd5b62000342888389016200027b565b9550602087015191508082111562000358575f80fd5b62000366888389016200027b565b945060408701519150808211156200037c575f80fd5b506200038b878288016200027b565b606087015190935090506001600160a01b0381168114620003aa575f80fd5b939692955090935050565b600181811c90821680620003ca57607f821691505b602082108103620003e957634e487b7160e01b5f52602260045260245ffd5b50919050565b601f8211156200043c575f81815260208120601f850160051c81016020861015620004175750805b601f850160051c820191505b81811015620004385782815560010162000423565b5050505b505050565b81516001600160401b038111156200045d576200045d62000243565b62000475816200046e8454620003b5565b84620003ef565b602080601f831160018114620004ab575f8415620004935750858301515b5f19600386901b1c1916600185901b17855562000438565b5f85815260208120601f198616915b82811015620004db57888601518255948401946001909101908401620004ba565b5085821015620004f957878501515f19600388901b60f8161c191681555b5050505050600190811b01905550565b602081525f82518060208401526200052981604085016020870162000257565b601f01601f19169190910160400192915050565b80516020808301519190811015620003e9575f1960209190910360031b1b16919050565b60805160a05160c05160e051610100516101205161014051612196620005b35f395f610e0a01525f610ddd01525f610d0801525f610ce001525f610c3b01525f610c6501525f610c8f01526121965ff3fe608060405234801561000f575f80fd5b50600436106101c6575f3560e01c806370a08231116100fe578063a14481941161009e578063d505accf1161006e578063d505accf146103cf578063e985e9c5146103e2578063f2fde38b146103f5578063f7dedf5e14610408575f80fd5b8063a144819414610383578063a22cb46514610396578063b88d4fde146103a9578063c87b56dd146103bc575f80fd5b806384b0196e116100d957806384b0196e1461033c5780638da5cb5b146103575780639032c7261461036857806395d89b411461037b575f80fd5b806370a08231146102f9578063715018a61461030c5780637ecebe0014610314575f80fd5b80633644e515116101695780634f6ccce7116101445780634f6ccce7146102ad57806355f804b3146102c0578063605629d6146102d35780636352211e146102e6575f80fd5b80633644e5151461027f57806342842e0e1461028757806342966c681461029a575f80fd5b8063095ea7b3116101a4578063095ea7b31461023257806318160ddd1461024757806323b872dd146102595780632f745c591461026c575f80fd5b806301ffc9a7146101ca57806306fdde03146101f2578063081812fc14610207575b5f80fd5b6101dd6101d8366004611a3c565b61041b565b60405190151581526020015b60405180910390f35b6101fa61042b565b6040516101e99190611aab565b61021a610215366004611abd565b6104ba565b6040516001600160a01b0390911681526020016101e9565b610245610240366004611aef565b6104e1565b005b6008545b6040519081526020016101e9565b610245610267366004611b17565b6104f0565b61024b61027a366004611aef565b61057e565b61024b6105e1565b610245610295366004611b17565b6105ef565b6102456102a8366004611abd565b61060e565b61024b6102bb366004611abd565b610619565b6102456102ce366004611b50565b61066e565b6102456102e1366004611bcc565b610683565b61021a6102f4366004611abd565b6106a6565b61024b610307366004611c31565b6106b0565b6102456106f5565b61024b610322366004611c31565b6001600160a01b03165f908152600e602052604090205490565b610344610708565b6040516101e99796959493929190611c4a565b600a546001600160a01b031661021a565b610245610376366004611ced565b61074a565b6101fa6108c0565b610245610391366004611aef565b6108cf565b6102456103a4366004611d3d565b6108e1565b6102456103b7366004611d82565b6108ec565b6101fa6103ca366004611abd565b610903565b6102456103dd366004611bcc565b610990565b6101dd6103f0366004611e57565b610b28565b610245610403366004611c31565b610b55565b610245610416366004611e7f565b610b92565b5f61042582610bb2565b92915050565b60605f805461043990611ed3565b80601f016020809104026020016040519081016040528092919081815260200182805461046590611ed3565b80156104b05780601f10610487576101008083540402835291602001916104b0565b820191905f5260205f20905b81548152906001019060200180831161049357829003601f168201915b5050505050905090565b5f6104c482610bd6565b505f828152600460205260409020546001600160a01b0316610425565b6104ec828233610c0e565b5050565b6001600160a01b03821661051e57604051633250574960e11b81525f60048201526024015b60405180910390fd5b5f61052a838333610c1b565b9050836001600160a01b0316816001600160a01b031614610578576040516364283d7b60e01b81526001600160a01b0380861660048301526024820184905282166044820152606401610515565b50505050565b5f610588836106b0565b82106105b95760405163295f44f760e21b81526001600160a01b038416600482015260248101839052604401610515565b506001600160a01b03919091165f908152600660209081526040808320938352929052205490565b5f6105ea610c2f565b905090565b61060983838360405180602001604052805f8152506108ec565b505050565b6104ec5f8233610c1b565b5f61062360085490565b821061064b5760405163295f44f760e21b81525f600482015260248101839052604401610515565b6008828154811061065e5761065e611f0b565b905f5260205f2001549050919050565b610676610d58565b600d610609828483611f64565b61069287338787878787610990565b61069d8787876105ef565b50505050505050565b5f61042582610bd6565b5f6001600160a01b0382166106da576040516322718ad960e21b81525f6004820152602401610515565b506001600160a01b03165f9081526003602052604090205490565b6106fd610d58565b6107065f610d85565b565b5f6060805f805f6060610719610dd6565b610721610e03565b604080515f80825260208201909252600f60f81b9b939a50919850469750309650945092509050565b8342111561076b57604051630819bdcd60e01b815260040160405180910390fd5b6001600160a01b0387165f908152600e6020526040812080547f47ab88482c90e4bb94b82a947ae78fa91fb25de1469ab491f4c15b9a0a2677ee918a918a918a9190866107b783612033565b909155506040805160208101969096526001600160a01b03948516908601529290911660608401521515608083015260a082015260c0810186905260e0016040516020818303038152906040528051906020012090505f61081782610e30565b90505f61082682878787610e5c565b9050896001600160a01b0316816001600160a01b03161461085a57604051632057875960e21b815260040160405180910390fd5b6108658a8a8a610e88565b886001600160a01b03168a6001600160a01b03167f03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b04778a6040516108ac911515815260200190565b60405180910390a350505050505050505050565b60606001805461043990611ed3565b6108d7610d58565b6104ec8282610f26565b6104ec338383610e88565b6108f78484846104f0565b61057884848484610f3f565b60605f61090f836106a6565b6001600160a01b0316036109365760405163d872946b60e01b815260040160405180910390fd5b5f600d805461094490611ed3565b90501161095f5760405180602001604052805f815250610425565b600d61096a83611065565b60405160200161097b92919061204b565b60405160208183030381529060405292915050565b834211156109b157604051630819bdcd60e01b815260040160405180910390fd5b6001600160a01b0387165f908152600e6020526040812080547f48d39b37a35214940203bbbd4f383519797769b13d936f387d89430afef27688918a918a918a9190866109fd83612033565b909155506040805160208101969096526001600160a01b0394851690860152929091166060840152608083015260a082015260c0810186905260e0016040516020818303038152906040528051906020012090505f610a5b82610e30565b90505f610a6a82878787610e5c565b9050896001600160a01b0316816001600160a01b031614610a9e57604051632057875960e21b815260040160405180910390fd5b896001600160a01b0316610ab1896106a6565b6001600160a01b031614610ad857604051632057875960e21b815260040160405180910390fd5b610ae389898c610c0e565b886001600160a01b03168a6001600160a01b03167f1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd43268a6040516108ac91815260200190565b6001600160a01b039182165f90815260056020908152604080832093909416825291909152205460ff1690565b610b5d610d58565b6001600160a01b038116610b8657604051631e4fbdf760e01b81525f6004820152602401610515565b610b8f81610d85565b50565b610ba186338787878787610990565b610baa8561060e565b505050505050565b5f6001600160e01b0319821663780e9d6360e01b14806104255750610425826110f5565b5f818152600260205260408120546001600160a01b03168061042557604051637e27328960e01b815260048101849052602401610515565b6106098383836001611144565b5f610c27848484611248565b949350505050565b5f306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016148015610c8757507f000000000000000000000000000000000000000000000000000000000000000046145b15610cb157507f000000000000000000000000000000000000000000000000000000000000000090565b6105ea604080517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60208201527f0000000000000000000000000000000000000000000000000000000000000000918101919091527f000000000000000000000000000000000000000000000000000000000000000060608201524660808201523060a08201525f9060c00160405160208183030381529060405280519060200120905090565b600a546001600160a01b031633146107065760405163118cdaa760e01b8152336004820152602401610515565b600a80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b60606105ea7f0000000000000000000000000000000000000000000000000000000000000000600b611313565b60606105ea7f0000000000000000000000000000000000000000000000000000000000000000600c611313565b5f610425610e3c610c2f565b8360405161190160f01b8152600281019290925260228201526042902090565b5f805f80610e6c888888886113bc565b925092509250610e7c8282611484565b50909695505050505050565b6001600160a01b038216610eba57604051630b61174360e31b81526001600160a01b0383166004820152602401610515565b6001600160a01b038381165f81815260056020908152604080832094871680845294825291829020805460ff191686151590811790915591519182527f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a3505050565b6104ec828260405180602001604052805f81525061153c565b6001600160a01b0383163b1561057857604051630a85bd0160e11b81526001600160a01b0384169063150b7a0290610f819033908890879087906004016120ce565b6020604051808303815f875af1925050508015610fbb575060408051601f3d908101601f19168201909252610fb89181019061210a565b60015b611022573d808015610fe8576040519150601f19603f3d011682016040523d82523d5f602084013e610fed565b606091505b5080515f0361101a57604051633250574960e11b81526001600160a01b0385166004820152602401610515565b805181602001fd5b6001600160e01b03198116630a85bd0160e11b1461105e57604051633250574960e11b81526001600160a01b0385166004820152602401610515565b5050505050565b60605f61107183611552565b60010190505f8167ffffffffffffffff81111561109057611090611d6e565b6040519080825280601f01601f1916602001820160405280156110ba576020820181803683370190505b5090508181016020015b5f19016f181899199a1a9b1b9c1cb0b131b232b360811b600a86061a8153600a85049450846110c457509392505050565b5f6001600160e01b031982166380ac58cd60e01b148061112557506001600160e01b03198216635b5e139f60e01b145b8061042557506301ffc9a760e01b6001600160e01b0319831614610425565b808061115857506001600160a01b03821615155b15611219575f61116784610bd6565b90506001600160a01b038316158015906111935750826001600160a01b0316816001600160a01b031614155b80156111a657506111a48184610b28565b155b156111cf5760405163a9fbf51f60e01b81526001600160a01b0384166004820152602401610515565b81156112175783856001600160a01b0316826001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45b505b50505f90815260046020526040902080546001600160a01b0319166001600160a01b0392909216919091179055565b5f80611255858585611629565b90506001600160a01b0381166112b1576112ac84600880545f838152600960205260408120829055600182018355919091527ff3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee30155565b6112d4565b846001600160a01b0316816001600160a01b0316146112d4576112d4818561171b565b6001600160a01b0385166112f0576112eb846117a8565b610c27565b846001600160a01b0316816001600160a01b031614610c2757610c27858561184f565b606060ff831461132d576113268361189d565b9050610425565b81805461133990611ed3565b80601f016020809104026020016040519081016040528092919081815260200182805461136590611ed3565b80156113b05780601f10611387576101008083540402835291602001916113b0565b820191905f5260205f20905b81548152906001019060200180831161139357829003601f168201915b50505050509050610425565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156113f557505f9150600390508261147a565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015611446573d5f803e3d5ffd5b5050604051601f1901519150506001600160a01b03811661147157505f92506001915082905061147a565b92505f91508190505b9450945094915050565b5f82600381111561149757611497612125565b036114a0575050565b60018260038111156114b4576114b4612125565b036114d25760405163f645eedf60e01b815260040160405180910390fd5b60028260038111156114e6576114e6612125565b036115075760405163fce698f760e01b815260048101829052602401610515565b600382600381111561151b5761151b612125565b036104ec576040516335e2f38360e21b815260048101829052602401610515565b61154683836118da565b6106095f848484610f3f565b5f8072184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b83106115905772184f03e93ff9f4daa797ed6e38ed64bf6a1f0160401b830492506040015b6d04ee2d6d415b85acef810000000083106115bc576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc1000083106115da57662386f26fc10000830492506010015b6305f5e10083106115f2576305f5e100830492506008015b612710831061160657612710830492506004015b60648310611618576064830492506002015b600a83106104255760010192915050565b5f828152600260205260408120546001600160a01b03908116908316156116555761165581848661193b565b6001600160a01b0381161561168f576116705f855f80611144565b6001600160a01b0381165f90815260036020526040902080545f190190555b6001600160a01b038516156116bd576001600160a01b0385165f908152600360205260409020805460010190555b5f8481526002602052604080822080546001600160a01b0319166001600160a01b0389811691821790925591518793918516917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4949350505050565b5f611725836106b0565b5f83815260076020526040902054909150808214611776576001600160a01b0384165f9081526006602090815260408083208584528252808320548484528184208190558352600790915290208190555b505f9182526007602090815260408084208490556001600160a01b039094168352600681528383209183525290812055565b6008545f906117b990600190612139565b5f83815260096020526040812054600880549394509092849081106117e0576117e0611f0b565b905f5260205f200154905080600883815481106117ff576117ff611f0b565b5f9182526020808320909101929092558281526009909152604080822084905585825281205560088054806118365761183661214c565b600190038181905f5260205f20015f9055905550505050565b5f600161185b846106b0565b6118659190612139565b6001600160a01b039093165f908152600660209081526040808320868452825280832085905593825260079052919091209190915550565b60605f6118a98361199f565b6040805160208082528183019092529192505f91906020820181803683375050509182525060208101929092525090565b6001600160a01b03821661190357604051633250574960e11b81525f6004820152602401610515565b5f61190f83835f610c1b565b90506001600160a01b03811615610609576040516339e3563760e11b81525f6004820152602401610515565b6119468383836119c6565b610609576001600160a01b03831661197457604051637e27328960e01b815260048101829052602401610515565b60405163177e802f60e01b81526001600160a01b038316600482015260248101829052604401610515565b5f60ff8216601f81111561042557604051632cd44ac360e21b815260040160405180910390fd5b5f6001600160a01b03831615801590610c275750826001600160a01b0316846001600160a01b031614806119ff57506119ff8484610b28565b80610c275750505f908152600460205260409020546001600160a01b03908116911614919050565b6001600160e01b031981168114610b8f575f80fd5b5f60208284031215611a4c575f80fd5b8135611a5781611a27565b9392505050565b5f5b83811015611a78578181015183820152602001611a60565b50505f910152565b5f8151808452611a97816020860160208601611a5e565b601f01601f19169290920160200192915050565b602081525f611a576020830184611a80565b5f60208284031215611acd575f80fd5b5035919050565b80356001600160a01b0381168114611aea575f80fd5b919050565b5f8060408385031215611b00575f80fd5b611b0983611ad4565b946020939093013593505050565b5f805f60608486031215611b29575f80fd5b611b3284611ad4565b9250611b4060208501611ad4565b9150604084013590509250925092565b5f8060208385031215611b61575f80fd5b823567ffffffffffffffff80821115611b78575f80fd5b818501915085601f830112611b8b575f80fd5b813581811115611b99575f80fd5b866020828501011115611baa575f80fd5b60209290920196919550909350505050565b803560ff81168114611aea575f80fd5b5f805f805f805f60e0888a031215611be2575f80fd5b611beb88611ad4565b9650611bf960208901611ad4565b95506040880135945060608801359350611c1560808901611bbc565b925060a0880135915060c0880135905092959891949750929550565b5f60208284031215611c41575f80fd5b611a5782611ad4565b60ff60f81b881681525f602060e081840152611c6960e084018a611a80565b8381036040850152611c7b818a611a80565b606085018990526001600160a01b038816608086015260a0850187905284810360c086015285518082528387019250908301905f5b81811015611ccc57835183529284019291840191600101611cb0565b50909c9b505050505050505050505050565b80358015158114611aea575f80fd5b5f805f805f805f60e0888a031215611d03575f80fd5b611d0c88611ad4565b9650611d1a60208901611ad4565b9550611d2860408901611cde565b945060608801359350611c1560808901611bbc565b5f8060408385031215611d4e575f80fd5b611d5783611ad4565b9150611d6560208401611cde565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f805f8060808587031215611d95575f80fd5b611d9e85611ad4565b9350611dac60208601611ad4565b925060408501359150606085013567ffffffffffffffff80821115611dcf575f80fd5b818701915087601f830112611de2575f80fd5b813581811115611df457611df4611d6e565b604051601f8201601f19908116603f01168101908382118183101715611e1c57611e1c611d6e565b816040528281528a6020848701011115611e34575f80fd5b826020860160208301375f60208483010152809550505050505092959194509250565b5f8060408385031215611e68575f80fd5b611e7183611ad4565b9150611d6560208401611ad4565b5f805f805f8060c08789031215611e94575f80fd5b611e9d87611ad4565b95506020870135945060408701359350611eb960608801611bbc565b92506080870135915060a087013590509295509295509295565b600181811c90821680611ee757607f821691505b602082108103611f0557634e487b7160e01b5f52602260045260245ffd5b50919050565b634e487b7160e01b5f52603260045260245ffd5b601f821115610609575f81815260208120601f850160051c81016020861015611f455750805b601f850160051c820191505b81811015610baa57828155600101611f51565b67ffffffffffffffff831115611f7c57611f7c611d6e565b611f9083611f8a8354611ed3565b83611f1f565b5f601f841160018114611fc1575f8515611faa5750838201355b5f19600387901b1c1916600186901b17835561105e565b5f83815260209020601f19861690835b82811015611ff15786850135825560209485019460019092019101611fd1565b508682101561200d575f1960f88860031b161c19848701351681555b505060018560011b0183555050505050565b634e487b7160e01b5f52601160045260245ffd5b5f600182016120445761204461201f565b5060010190565b5f80845461205881611ed3565b600182811680156120705760018114612085576120b1565b60ff19841687528215158302870194506120b1565b885f526020805f205f5b858110156120a85781548a82015290840190820161208f565b50505082870194505b5050505083516120c5818360208801611a5e565b01949350505050565b6001600160a01b03858116825284166020820152604081018390526080606082018190525f9061210090830184611a80565b9695505050505050565b5f6020828403121561211a575f80fd5b8151611a5781611a27565b634e487b7160e01b5f52602160045260245ffd5b818103818111156104255761042561201f565b634e487b7160e01b5f52603160045260245ffdfea2646970667358221220ae8a558b711a3e15ba0422d931dc3f5ffdc624d2e3472b154788f3947cb98c1464736f6c63430008140033",
+}
+
+// LBBCertABI is the input ABI used to generate the binding from.
+// Deprecated: Use LBBCertMetaData.ABI instead.
+var LBBCertABI = LBBCertMetaData.ABI
+
+// LBBCertBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use LBBCertMetaData.Bin instead.
+var LBBCertBin = LBBCertMetaData.Bin
+
+// DeployLBBCert deploys a new Ethereum contract, binding an instance of LBBCert to it.
+func DeployLBBCert(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, baseURI string, initialOwner common.Address) (common.Address, *types.Transaction, *LBBCert, error) {
+	parsed, err := LBBCertMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(LBBCertBin), backend, name, symbol, baseURI, initialOwner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &LBBCert{LBBCertCaller: LBBCertCaller{contract: contract}, LBBCertTransactor: LBBCertTransactor{contract: contract}, LBBCertFilterer: LBBCertFilterer{contract: contract}}, nil
+}
+
+// LBBCert is an auto generated Go binding around an Ethereum contract.
+type LBBCert struct {
+	LBBCertCaller     // Read-only binding to the contract
+	LBBCertTransactor // Write-only binding to the contract
+	LBBCertFilterer   // Log filterer for contract events
+}
+
+// LBBCertCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LBBCertCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LBBCertTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LBBCertFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LBBCertSession struct {
+	Contract     *LBBCert          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LBBCertCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LBBCertCallerSession struct {
+	Contract *LBBCertCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// LBBCertTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LBBCertTransactorSession struct {
+	Contract     *LBBCertTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// LBBCertRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LBBCertRaw struct {
+	Contract *LBBCert // Generic contract binding to access the raw methods on
+}
+
+// LBBCertCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LBBCertCallerRaw struct {
+	Contract *LBBCertCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LBBCertTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LBBCertTransactorRaw struct {
+	Contract *LBBCertTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLBBCert creates a new instance of LBBCert, bound to a specific deployed contract.
+func NewLBBCert(address common.Address, backend bind.ContractBackend) (*LBBCert, error) {
+	contract, err := bindLBBCert(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCert{LBBCertCaller: LBBCertCaller{contract: contract}, LBBCertTransactor: LBBCertTransactor{contract: contract}, LBBCertFilterer: LBBCertFilterer{contract: contract}}, nil
+}
+
+// NewLBBCertCaller creates a new read-only instance of LBBCert, bound to a specific deployed contract.
+func NewLBBCertCaller(address common.Address, caller bind.ContractCaller) (*LBBCertCaller, error) {
+	contract, err := bindLBBCert(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertCaller{contract: contract}, nil
+}
+
+// NewLBBCertTransactor creates a new write-only instance of LBBCert, bound to a specific deployed contract.
+func NewLBBCertTransactor(address common.Address, transactor bind.ContractTransactor) (*LBBCertTransactor, error) {
+	contract, err := bindLBBCert(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertTransactor{contract: contract}, nil
+}
+
+// NewLBBCertFilterer creates a new log filterer instance of LBBCert, bound to a specific deployed contract.
+func NewLBBCertFilterer(address common.Address, filterer bind.ContractFilterer) (*LBBCertFilterer, error) {
+	contract, err := bindLBBCert(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertFilterer{contract: contract}, nil
+}
+
+// bindLBBCert binds a generic wrapper to an already deployed contract.
+func bindLBBCert(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LBBCertMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCert *LBBCertRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCert.Contract.LBBCertCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCert *LBBCertRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCert.Contract.LBBCertTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCert *LBBCertRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCert.Contract.LBBCertTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCert *LBBCertCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCert.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCert *LBBCertTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCert.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCert *LBBCertTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCert.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCert *LBBCertCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCert *LBBCertSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCert.Contract.DOMAINSEPARATOR(&_LBBCert.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCert *LBBCertCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCert.Contract.DOMAINSEPARATOR(&_LBBCert.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCert *LBBCertCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCert *LBBCertSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCert.Contract.BalanceOf(&_LBBCert.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCert *LBBCertCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCert.Contract.BalanceOf(&_LBBCert.CallOpts, owner)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCert *LBBCertCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCert *LBBCertSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCert.Contract.Eip712Domain(&_LBBCert.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCert *LBBCertCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCert.Contract.Eip712Domain(&_LBBCert.CallOpts)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCert.Contract.GetApproved(&_LBBCert.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCert.Contract.GetApproved(&_LBBCert.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCert *LBBCertCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCert *LBBCertSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCert.Contract.IsApprovedForAll(&_LBBCert.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCert *LBBCertCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCert.Contract.IsApprovedForAll(&_LBBCert.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCert *LBBCertCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCert *LBBCertSession) Name() (string, error) {
+	return _LBBCert.Contract.Name(&_LBBCert.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCert *LBBCertCallerSession) Name() (string, error) {
+	return _LBBCert.Contract.Name(&_LBBCert.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCert *LBBCertCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCert *LBBCertSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _LBBCert.Contract.Nonces(&_LBBCert.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCert *LBBCertCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _LBBCert.Contract.Nonces(&_LBBCert.CallOpts, owner)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCert *LBBCertCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCert *LBBCertSession) Owner() (common.Address, error) {
+	return _LBBCert.Contract.Owner(&_LBBCert.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCert *LBBCertCallerSession) Owner() (common.Address, error) {
+	return _LBBCert.Contract.Owner(&_LBBCert.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCert.Contract.OwnerOf(&_LBBCert.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCert *LBBCertCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCert.Contract.OwnerOf(&_LBBCert.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCert *LBBCertCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCert *LBBCertSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCert.Contract.SupportsInterface(&_LBBCert.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCert *LBBCertCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCert.Contract.SupportsInterface(&_LBBCert.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCert *LBBCertCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCert *LBBCertSession) Symbol() (string, error) {
+	return _LBBCert.Contract.Symbol(&_LBBCert.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCert *LBBCertCallerSession) Symbol() (string, error) {
+	return _LBBCert.Contract.Symbol(&_LBBCert.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCert.Contract.TokenByIndex(&_LBBCert.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCert.Contract.TokenByIndex(&_LBBCert.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCert.Contract.TokenOfOwnerByIndex(&_LBBCert.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCert *LBBCertCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCert.Contract.TokenOfOwnerByIndex(&_LBBCert.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCert *LBBCertCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCert *LBBCertSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCert.Contract.TokenURI(&_LBBCert.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCert *LBBCertCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCert.Contract.TokenURI(&_LBBCert.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCert *LBBCertCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCert.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCert *LBBCertSession) TotalSupply() (*big.Int, error) {
+	return _LBBCert.Contract.TotalSupply(&_LBBCert.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCert *LBBCertCallerSession) TotalSupply() (*big.Int, error) {
+	return _LBBCert.Contract.TotalSupply(&_LBBCert.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.Approve(&_LBBCert.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.Approve(&_LBBCert.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCert *LBBCertSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.Burn(&_LBBCert.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.Burn(&_LBBCert.TransactOpts, tokenId)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactor) BurnWithPermit(opts *bind.TransactOpts, from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "burnWithPermit", from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertSession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.BurnWithPermit(&_LBBCert.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactorSession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.BurnWithPermit(&_LBBCert.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "permit", owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.Permit(&_LBBCert.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactorSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.Permit(&_LBBCert.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactor) PermitForAll(opts *bind.TransactOpts, owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "permitForAll", owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.PermitForAll(&_LBBCert.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactorSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.PermitForAll(&_LBBCert.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCert *LBBCertTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCert *LBBCertSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCert.Contract.RenounceOwnership(&_LBBCert.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCert *LBBCertTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCert.Contract.RenounceOwnership(&_LBBCert.TransactOpts)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactor) SafeMint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "safeMint", to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertSession) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeMint(&_LBBCert.TransactOpts, to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactorSession) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeMint(&_LBBCert.TransactOpts, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeTransferFrom(&_LBBCert.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeTransferFrom(&_LBBCert.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCert *LBBCertTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCert *LBBCertSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeTransferFrom0(&_LBBCert.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCert *LBBCertTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.SafeTransferFrom0(&_LBBCert.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCert *LBBCertTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCert *LBBCertSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCert.Contract.SetApprovalForAll(&_LBBCert.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCert *LBBCertTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCert.Contract.SetApprovalForAll(&_LBBCert.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCert *LBBCertTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI string) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "setBaseURI", baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCert *LBBCertSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCert.Contract.SetBaseURI(&_LBBCert.TransactOpts, baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCert *LBBCertTransactorSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCert.Contract.SetBaseURI(&_LBBCert.TransactOpts, baseURI)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferFrom(&_LBBCert.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCert *LBBCertTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferFrom(&_LBBCert.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCert *LBBCertTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCert *LBBCertSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferOwnership(&_LBBCert.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCert *LBBCertTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferOwnership(&_LBBCert.TransactOpts, newOwner)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactor) TransferWithPermit(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.contract.Transact(opts, "transferWithPermit", from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertSession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferWithPermit(&_LBBCert.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCert *LBBCertTransactorSession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCert.Contract.TransferWithPermit(&_LBBCert.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// LBBCertApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LBBCert contract.
+type LBBCertApprovalIterator struct {
+	Event *LBBCertApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertApproval represents a Approval event raised by the LBBCert contract.
+type LBBCertApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*LBBCertApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertApprovalIterator{contract: _LBBCert.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LBBCertApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertApproval)
+				if err := _LBBCert.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) ParseApproval(log types.Log) (*LBBCertApproval, error) {
+	event := new(LBBCertApproval)
+	if err := _LBBCert.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the LBBCert contract.
+type LBBCertApprovalForAllIterator struct {
+	Event *LBBCertApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertApprovalForAll represents a ApprovalForAll event raised by the LBBCert contract.
+type LBBCertApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertApprovalForAllIterator{contract: _LBBCert.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *LBBCertApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertApprovalForAll)
+				if err := _LBBCert.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) ParseApprovalForAll(log types.Log) (*LBBCertApprovalForAll, error) {
+	event := new(LBBCertApprovalForAll)
+	if err := _LBBCert.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the LBBCert contract.
+type LBBCertEIP712DomainChangedIterator struct {
+	Event *LBBCertEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertEIP712DomainChanged represents a EIP712DomainChanged event raised by the LBBCert contract.
+type LBBCertEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCert *LBBCertFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*LBBCertEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertEIP712DomainChangedIterator{contract: _LBBCert.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCert *LBBCertFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *LBBCertEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertEIP712DomainChanged)
+				if err := _LBBCert.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCert *LBBCertFilterer) ParseEIP712DomainChanged(log types.Log) (*LBBCertEIP712DomainChanged, error) {
+	event := new(LBBCertEIP712DomainChanged)
+	if err := _LBBCert.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LBBCert contract.
+type LBBCertOwnershipTransferredIterator struct {
+	Event *LBBCertOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertOwnershipTransferred represents a OwnershipTransferred event raised by the LBBCert contract.
+type LBBCertOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCert *LBBCertFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LBBCertOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertOwnershipTransferredIterator{contract: _LBBCert.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCert *LBBCertFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LBBCertOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertOwnershipTransferred)
+				if err := _LBBCert.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCert *LBBCertFilterer) ParseOwnershipTransferred(log types.Log) (*LBBCertOwnershipTransferred, error) {
+	event := new(LBBCertOwnershipTransferred)
+	if err := _LBBCert.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertPermitForAllUsedIterator is returned from FilterPermitForAllUsed and is used to iterate over the raw logs and unpacked data for PermitForAllUsed events raised by the LBBCert contract.
+type LBBCertPermitForAllUsedIterator struct {
+	Event *LBBCertPermitForAllUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertPermitForAllUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertPermitForAllUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertPermitForAllUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertPermitForAllUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertPermitForAllUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertPermitForAllUsed represents a PermitForAllUsed event raised by the LBBCert contract.
+type LBBCertPermitForAllUsed struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitForAllUsed is a free log retrieval operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) FilterPermitForAllUsed(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertPermitForAllUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertPermitForAllUsedIterator{contract: _LBBCert.contract, event: "PermitForAllUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitForAllUsed is a free log subscription operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) WatchPermitForAllUsed(opts *bind.WatchOpts, sink chan<- *LBBCertPermitForAllUsed, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertPermitForAllUsed)
+				if err := _LBBCert.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitForAllUsed is a log parse operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCert *LBBCertFilterer) ParsePermitForAllUsed(log types.Log) (*LBBCertPermitForAllUsed, error) {
+	event := new(LBBCertPermitForAllUsed)
+	if err := _LBBCert.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertPermitUsedIterator is returned from FilterPermitUsed and is used to iterate over the raw logs and unpacked data for PermitUsed events raised by the LBBCert contract.
+type LBBCertPermitUsedIterator struct {
+	Event *LBBCertPermitUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertPermitUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertPermitUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertPermitUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertPermitUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertPermitUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertPermitUsed represents a PermitUsed event raised by the LBBCert contract.
+type LBBCertPermitUsed struct {
+	Owner   common.Address
+	Spender common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitUsed is a free log retrieval operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) FilterPermitUsed(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*LBBCertPermitUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertPermitUsedIterator{contract: _LBBCert.contract, event: "PermitUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitUsed is a free log subscription operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) WatchPermitUsed(opts *bind.WatchOpts, sink chan<- *LBBCertPermitUsed, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertPermitUsed)
+				if err := _LBBCert.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitUsed is a log parse operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) ParsePermitUsed(log types.Log) (*LBBCertPermitUsed, error) {
+	event := new(LBBCertPermitUsed)
+	if err := _LBBCert.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LBBCert contract.
+type LBBCertTransferIterator struct {
+	Event *LBBCertTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertTransfer represents a Transfer event raised by the LBBCert contract.
+type LBBCertTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*LBBCertTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertTransferIterator{contract: _LBBCert.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LBBCertTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertTransfer)
+				if err := _LBBCert.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCert *LBBCertFilterer) ParseTransfer(log types.Log) (*LBBCertTransfer, error) {
+	event := new(LBBCertTransfer)
+	if err := _LBBCert.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertSafeMintEventIterator is returned from FilterSafeMintEvent and is used to iterate over the raw logs and unpacked data for SafeMintEvent events raised by the LBBCert contract.
+type LBBCertSafeMintEventIterator struct {
+	Event *LBBCertSafeMintEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertSafeMintEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertSafeMintEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertSafeMintEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertSafeMintEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertSafeMintEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertSafeMintEvent represents a SafeMintEvent event raised by the LBBCert contract.
+type LBBCertSafeMintEvent struct {
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeMintEvent is a free log retrieval operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) FilterSafeMintEvent(opts *bind.FilterOpts) (*LBBCertSafeMintEventIterator, error) {
+
+	logs, sub, err := _LBBCert.contract.FilterLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertSafeMintEventIterator{contract: _LBBCert.contract, event: "safeMintEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeMintEvent is a free log subscription operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) WatchSafeMintEvent(opts *bind.WatchOpts, sink chan<- *LBBCertSafeMintEvent) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCert.contract.WatchLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertSafeMintEvent)
+				if err := _LBBCert.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeMintEvent is a log parse operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCert *LBBCertFilterer) ParseSafeMintEvent(log types.Log) (*LBBCertSafeMintEvent, error) {
+	event := new(LBBCertSafeMintEvent)
+	if err := _LBBCert.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
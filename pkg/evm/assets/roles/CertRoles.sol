@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {ERC721} from "openzeppelin-contracts/token/ERC721/ERC721.sol";
+import {ERC721Enumerable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import {ERC721Burnable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Burnable.sol";
+import {Strings} from "openzeppelin-contracts/utils/Strings.sol";
+import {AccessControlEnumerable} from "openzeppelin-contracts/access/extensions/AccessControlEnumerable.sol";
+
+error NonExistentTokenURI();
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
+
+/**
+ * @dev Certificate contract for several issuer services, each with its own key.
+ *
+ * Minting, burning and the base URI are gated by roles instead of a single owner. The
+ * default admin grants and revokes them. safeMint(address,uint256) has the signature of
+ * LBBCert and safeMint(address) the signature of CertAutoID, so existing clients of both
+ * mint here with any key that holds MINTER_ROLE.
+ */
+contract LBBCertRoles is ERC721, ERC721Enumerable, ERC721Burnable, AccessControlEnumerable {
+    using Strings for uint256;
+    string private _baseTokenURI;
+    uint256 private _nextTokenId;
+
+    bytes32 public constant MINTER_ROLE = keccak256("MINTER_ROLE");
+    bytes32 public constant BURNER_ROLE = keccak256("BURNER_ROLE");
+    bytes32 public constant URI_SETTER_ROLE = keccak256("URI_SETTER_ROLE");
+
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
+    // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event safeMintEvent(address to, uint256 tokenId);
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        string memory baseURI,
+        address defaultAdmin
+    ) ERC721(name, symbol) {
+        _baseTokenURI = baseURI;
+        _nextTokenId = 1;
+
+        _grantRole(DEFAULT_ADMIN_ROLE, defaultAdmin);
+        _grantRole(MINTER_ROLE, defaultAdmin);
+        _grantRole(BURNER_ROLE, defaultAdmin);
+        _grantRole(URI_SETTER_ROLE, defaultAdmin);
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyRole(MINTER_ROLE) {
+        _safeMint(to, tokenId);
+    }
+
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyRole(MINTER_ROLE) {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+        }
+    }
+
+    /**
+     * @dev Mints the next free token ID, skipping IDs already minted with safeMint(address,uint256)
+     */
+    function safeMint(address to) public onlyRole(MINTER_ROLE) returns (uint256) {
+        uint256 tokenId = _nextTokenId;
+        while (_ownerOf(tokenId) != address(0)) {
+            tokenId++;
+        }
+        _nextTokenId = tokenId + 1;
+
+        _safeMint(to, tokenId);
+        emit safeMintEvent(to, tokenId);
+        return tokenId;
+    }
+
+    function nextTokenId() public view virtual returns (uint256) {
+        return _nextTokenId;
+    }
+
+    /**
+     * @dev Holders and approved accounts burn as in ERC721Burnable, BURNER_ROLE burns any token
+     */
+    function burn(uint256 tokenId) public virtual override {
+        if (hasRole(BURNER_ROLE, _msgSender())) {
+            _burn(tokenId);
+            return;
+        }
+        super.burn(tokenId);
+    }
+
+    // BASE URI
+    function _baseURI() internal view virtual override returns (string memory) {
+        return _baseTokenURI;
+    }
+
+    function setBaseURI(string calldata baseURI) external onlyRole(URI_SETTER_ROLE) {
+        _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyRole(URI_SETTER_ROLE) {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyRole(URI_SETTER_ROLE) {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function tokenURI(
+        uint256 tokenId
+    ) public view virtual override returns (string memory) {
+        if (ownerOf(tokenId) == address(0)) {
+            revert NonExistentTokenURI();
+        }
+        return
+            bytes(_baseTokenURI).length > 0
+                ? string(abi.encodePacked(_baseTokenURI, tokenId.toString()))
+                : "";
+    }
+
+    // The following functions are overrides required by Solidity.
+
+    function _update(
+        address to,
+        uint256 tokenId,
+        address auth
+    ) internal override(ERC721, ERC721Enumerable) returns (address) {
+        return super._update(to, tokenId, auth);
+    }
+
+    function _increaseBalance(
+        address account,
+        uint128 value
+    ) internal override(ERC721, ERC721Enumerable) {
+        super._increaseBalance(account, value);
+    }
+
+    function supportsInterface(
+        bytes4 interfaceId
+    ) public view override(ERC721, ERC721Enumerable, AccessControlEnumerable) returns (bool) {
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
+    }
+}
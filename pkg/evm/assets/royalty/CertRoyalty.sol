@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {ERC721} from "openzeppelin-contracts/token/ERC721/ERC721.sol";
+import {ERC721Enumerable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Enumerable.sol";
+import {ERC721Burnable} from "openzeppelin-contracts/token/ERC721/extensions/ERC721Burnable.sol";
+import {Strings} from "openzeppelin-contracts/utils/Strings.sol";
+import {Ownable} from "openzeppelin-contracts/access/Ownable.sol";
+import {ECDSA} from "openzeppelin-contracts/utils/cryptography/ECDSA.sol";
+import {EIP712} from "openzeppelin-contracts/utils/cryptography/EIP712.sol";
+import {ERC2981} from "openzeppelin-contracts/token/common/ERC2981.sol";
+
+error NonExistentTokenURI();
+error InvalidSignature();
+error SignatureExpired();
+error InvalidSigner();
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
+
+/**
+ * @dev LBBCert with ERC-2981 royalty information for resold certificates.
+ *
+ * A default royalty applies to every token, a per-token royalty overrides it. Fees are in
+ * basis points of the sale price (10000 is 100%). Marketplaces read them with royaltyInfo,
+ * paying the royalty is up to the marketplace.
+ */
+contract LBBCertRoyalty is ERC721, ERC721Enumerable, ERC721Burnable, Ownable, EIP712, ERC2981 {
+    using Strings for uint256;
+    string private _baseTokenURI;
+
+    // Nonces for permit
+    mapping(address => uint256) private _nonces;
+
+    // EIP-712 Type Hashes
+    bytes32 private constant PERMIT_TYPEHASH =
+        keccak256(
+            "Permit(address owner,address spender,uint256 tokenId,uint256 nonce,uint256 deadline)"
+        );
+
+    bytes32 private constant PERMIT_FOR_ALL_TYPEHASH =
+        keccak256(
+            "PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"
+        );
+
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
+    // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event safeMintEvent(address to, uint256 tokenId);
+    event PermitUsed(
+        address indexed owner,
+        address indexed spender,
+        uint256 tokenId
+    );
+    event PermitForAllUsed(
+        address indexed owner,
+        address indexed operator,
+        bool approved
+    );
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        string memory baseURI,
+        address initialOwner,
+        address royaltyReceiver,
+        uint96 royaltyFeeNumerator
+    ) ERC721(name, symbol) EIP712(name, "1") Ownable(initialOwner) {
+        _baseTokenURI = baseURI;
+        if (royaltyReceiver != address(0)) {
+            _setDefaultRoyalty(royaltyReceiver, royaltyFeeNumerator);
+        }
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyOwner {
+        _safeMint(to, tokenId);
+    }
+
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyOwner {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+        }
+    }
+
+    // ROYALTIES
+    function setDefaultRoyalty(address receiver, uint96 feeNumerator) external onlyOwner {
+        _setDefaultRoyalty(receiver, feeNumerator);
+    }
+
+    function deleteDefaultRoyalty() external onlyOwner {
+        _deleteDefaultRoyalty();
+    }
+
+    /**
+     * @dev Overrides the default royalty for tokenId, the token does not need to exist yet
+     */
+    function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) external onlyOwner {
+        _setTokenRoyalty(tokenId, receiver, feeNumerator);
+    }
+
+    /**
+     * @dev Makes tokenId fall back to the default royalty
+     */
+    function resetTokenRoyalty(uint256 tokenId) external onlyOwner {
+        _resetTokenRoyalty(tokenId);
+    }
+
+    // BASE URI
+    function _baseURI() internal view virtual override returns (string memory) {
+        return _baseTokenURI;
+    }
+
+    function setBaseURI(string calldata baseURI) external onlyOwner {
+        _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function tokenURI(
+        uint256 tokenId
+    ) public view virtual override returns (string memory) {
+        if (ownerOf(tokenId) == address(0)) {
+            revert NonExistentTokenURI();
+        }
+        return
+            bytes(_baseTokenURI).length > 0
+                ? string(abi.encodePacked(_baseTokenURI, tokenId.toString()))
+                : "";
+    }
+
+    // ============ EIP-2612 Style Permit Functions ============
+
+    /**
+     * @dev Returns the current nonce for `owner`. This value must be included in the signature.
+     */
+    function nonces(address owner) public view returns (uint256) {
+        return _nonces[owner];
+    }
+
+    /**
+     * @dev Returns the domain separator for the current chain.
+     */
+    function DOMAIN_SEPARATOR() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+
+    /**
+     * @dev Permit approval for a specific token using EIP-712 signature
+     * @param owner The owner of the token
+     * @param spender The address to approve
+     * @param tokenId The token ID to approve
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function permit(
+        address owner,
+        address spender,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        if (block.timestamp > deadline) {
+            revert SignatureExpired();
+        }
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_TYPEHASH,
+                owner,
+                spender,
+                tokenId,
+                _nonces[owner]++,
+                deadline
+            )
+        );
+
+        bytes32 hash = _hashTypedDataV4(structHash);
+        address signer = ECDSA.recover(hash, v, r, s);
+
+        if (signer != owner) {
+            revert InvalidSigner();
+        }
+
+        if (ownerOf(tokenId) != owner) {
+            revert InvalidSigner();
+        }
+
+        _approve(spender, tokenId, owner);
+        emit PermitUsed(owner, spender, tokenId);
+    }
+
+    /**
+     * @dev Permit approval for all tokens using EIP-712 signature (setApprovalForAll)
+     * @param owner The owner granting approval
+     * @param operator The operator to approve/revoke
+     * @param approved Whether to approve or revoke
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function permitForAll(
+        address owner,
+        address operator,
+        bool approved,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        if (block.timestamp > deadline) {
+            revert SignatureExpired();
+        }
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_FOR_ALL_TYPEHASH,
+                owner,
+                operator,
+                approved,
+                _nonces[owner]++,
+                deadline
+            )
+        );
+
+        bytes32 hash = _hashTypedDataV4(structHash);
+        address signer = ECDSA.recover(hash, v, r, s);
+
+        if (signer != owner) {
+            revert InvalidSigner();
+        }
+
+        _setApprovalForAll(owner, operator, approved);
+        emit PermitForAllUsed(owner, operator, approved);
+    }
+
+    /**
+     * @dev Transfer token using permit signature (gasless transfer)
+     * @param from The current owner
+     * @param to The recipient
+     * @param tokenId The token ID to transfer
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function transferWithPermit(
+        address from,
+        address to,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        // First, validate the permit and approve msg.sender
+        permit(from, msg.sender, tokenId, deadline, v, r, s);
+
+        // Then transfer the token
+        safeTransferFrom(from, to, tokenId);
+    }
+
+    function burnWithPermit(
+        address from,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        permit(from, msg.sender, tokenId, deadline, v, r, s);
+
+        burn(tokenId);
+    }
+
+    // ============ End of Permit Functions ============
+
+    // The following functions are overrides required by Solidity.
+
+    function _update(
+        address to,
+        uint256 tokenId,
+        address auth
+    ) internal override(ERC721, ERC721Enumerable) returns (address) {
+        return super._update(to, tokenId, auth);
+    }
+
+    function _increaseBalance(
+        address account,
+        uint128 value
+    ) internal override(ERC721, ERC721Enumerable) {
+        super._increaseBalance(account, value);
+    }
+
+    function supportsInterface(
+        bytes4 interfaceId
+    ) public view override(ERC721, ERC721Enumerable, ERC2981) returns (bool) {
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
+    }
+}
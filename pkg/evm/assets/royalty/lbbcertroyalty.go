@@ -0,0 +1,2647 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package royalty
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// LBBCertRoyaltyMetaData contains all meta data concerning the LBBCertRoyalty contract.
+var LBBCertRoyaltyMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"symbol\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"initialOwner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"royaltyReceiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"royaltyFeeNumerator\",\"type\":\"uint96\",\"internalType\":\"uint96\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DOMAIN_SEPARATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"approve\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"balanceOf\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burn\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"burnWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"deleteDefaultRoyalty\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"emitBatchMetadataUpdate\",\"inputs\":[{\"name\":\"fromTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"toTokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emitMetadataUpdate\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getApproved\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isApprovedForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"nonces\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ownerOf\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"permit\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"permitForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetTokenRoyalty\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"royaltyInfo\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"salePrice\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"safeMint\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeMintBatch\",\"inputs\":[{\"name\":\"to\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ids\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"safeTransferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setApprovalForAll\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setBaseURI\",\"inputs\":[{\"name\":\"baseURI\",\"type\":\"string\",\"internalType\":\"string\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDefaultRoyalty\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feeNumerator\",\"type\":\"uint96\",\"internalType\":\"uint96\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setTokenRoyalty\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feeNumerator\",\"type\":\"uint96\",\"internalType\":\"uint96\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"symbol\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenByIndex\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenOfOwnerByIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"tokenURI\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalSupply\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"transferFrom\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferWithPermit\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"deadline\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"v\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"r\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Approval\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ApprovalForAll\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"BatchMetadataUpdate\",\"inputs\":[{\"name\":\"_fromTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"_toTokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MetadataUpdate\",\"inputs\":[{\"name\":\"_tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitForAllUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"approved\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"PermitUsed\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Transfer\",\"inputs\":[{\"name\":\"from\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"to\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"safeMintEvent\",\"inputs\":[{\"name\":\"to\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"BatchLengthMismatch\",\"inputs\":[{\"name\":\"recipients\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"tokenIds\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"ERC2981InvalidDefaultRoyalty\",\"inputs\":[{\"name\":\"numerator\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denominator\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC2981InvalidDefaultRoyaltyReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC2981InvalidTokenRoyalty\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"numerator\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"denominator\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC2981InvalidTokenRoyaltyReceiver\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721EnumerableForbiddenBatchMint\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ERC721IncorrectOwner\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InsufficientApproval\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidApprover\",\"inputs\":[{\"name\":\"approver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOperator\",\"inputs\":[{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidReceiver\",\"inputs\":[{\"name\":\"receiver\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721InvalidSender\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ERC721NonexistentToken\",\"inputs\":[{\"name\":\"tokenId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ERC721OutOfBoundsIndex\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"InvalidShortString\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSigner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NonExistentTokenURI\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"SignatureExpired\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"StringTooLong\",\"inputs\":[{\"name\":\"str\",\"type\":\"string\",\"internalType\":\"string\"}]}]",
+}
+
+// LBBCertRoyaltyABI is the input ABI used to generate the binding from.
+// Deprecated: Use LBBCertRoyaltyMetaData.ABI instead.
+var LBBCertRoyaltyABI = LBBCertRoyaltyMetaData.ABI
+
+// LBBCertRoyalty is an auto generated Go binding around an Ethereum contract.
+type LBBCertRoyalty struct {
+	LBBCertRoyaltyCaller     // Read-only binding to the contract
+	LBBCertRoyaltyTransactor // Write-only binding to the contract
+	LBBCertRoyaltyFilterer   // Log filterer for contract events
+}
+
+// LBBCertRoyaltyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type LBBCertRoyaltyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertRoyaltyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type LBBCertRoyaltyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertRoyaltyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type LBBCertRoyaltyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// LBBCertRoyaltySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type LBBCertRoyaltySession struct {
+	Contract     *LBBCertRoyalty   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// LBBCertRoyaltyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type LBBCertRoyaltyCallerSession struct {
+	Contract *LBBCertRoyaltyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// LBBCertRoyaltyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type LBBCertRoyaltyTransactorSession struct {
+	Contract     *LBBCertRoyaltyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// LBBCertRoyaltyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type LBBCertRoyaltyRaw struct {
+	Contract *LBBCertRoyalty // Generic contract binding to access the raw methods on
+}
+
+// LBBCertRoyaltyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type LBBCertRoyaltyCallerRaw struct {
+	Contract *LBBCertRoyaltyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// LBBCertRoyaltyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type LBBCertRoyaltyTransactorRaw struct {
+	Contract *LBBCertRoyaltyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewLBBCertRoyalty creates a new instance of LBBCertRoyalty, bound to a specific deployed contract.
+func NewLBBCertRoyalty(address common.Address, backend bind.ContractBackend) (*LBBCertRoyalty, error) {
+	contract, err := bindLBBCertRoyalty(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyalty{LBBCertRoyaltyCaller: LBBCertRoyaltyCaller{contract: contract}, LBBCertRoyaltyTransactor: LBBCertRoyaltyTransactor{contract: contract}, LBBCertRoyaltyFilterer: LBBCertRoyaltyFilterer{contract: contract}}, nil
+}
+
+// NewLBBCertRoyaltyCaller creates a new read-only instance of LBBCertRoyalty, bound to a specific deployed contract.
+func NewLBBCertRoyaltyCaller(address common.Address, caller bind.ContractCaller) (*LBBCertRoyaltyCaller, error) {
+	contract, err := bindLBBCertRoyalty(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyCaller{contract: contract}, nil
+}
+
+// NewLBBCertRoyaltyTransactor creates a new write-only instance of LBBCertRoyalty, bound to a specific deployed contract.
+func NewLBBCertRoyaltyTransactor(address common.Address, transactor bind.ContractTransactor) (*LBBCertRoyaltyTransactor, error) {
+	contract, err := bindLBBCertRoyalty(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyTransactor{contract: contract}, nil
+}
+
+// NewLBBCertRoyaltyFilterer creates a new log filterer instance of LBBCertRoyalty, bound to a specific deployed contract.
+func NewLBBCertRoyaltyFilterer(address common.Address, filterer bind.ContractFilterer) (*LBBCertRoyaltyFilterer, error) {
+	contract, err := bindLBBCertRoyalty(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyFilterer{contract: contract}, nil
+}
+
+// bindLBBCertRoyalty binds a generic wrapper to an already deployed contract.
+func bindLBBCertRoyalty(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := LBBCertRoyaltyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertRoyalty *LBBCertRoyaltyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertRoyalty.Contract.LBBCertRoyaltyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertRoyalty *LBBCertRoyaltyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.LBBCertRoyaltyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertRoyalty *LBBCertRoyaltyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.LBBCertRoyaltyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _LBBCertRoyalty.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCertRoyalty.Contract.DOMAINSEPARATOR(&_LBBCertRoyalty.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _LBBCertRoyalty.Contract.DOMAINSEPARATOR(&_LBBCertRoyalty.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.BalanceOf(&_LBBCertRoyalty.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.BalanceOf(&_LBBCertRoyalty.CallOpts, owner)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "eip712Domain")
+
+	outstruct := new(struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Fields = *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+	outstruct.Name = *abi.ConvertType(out[1], new(string)).(*string)
+	outstruct.Version = *abi.ConvertType(out[2], new(string)).(*string)
+	outstruct.ChainId = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VerifyingContract = *abi.ConvertType(out[4], new(common.Address)).(*common.Address)
+	outstruct.Salt = *abi.ConvertType(out[5], new([32]byte)).(*[32]byte)
+	outstruct.Extensions = *abi.ConvertType(out[6], new([]*big.Int)).(*[]*big.Int)
+
+	return *outstruct, err
+
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCertRoyalty.Contract.Eip712Domain(&_LBBCertRoyalty.CallOpts)
+}
+
+// Eip712Domain is a free data retrieval call binding the contract method 0x84b0196e.
+//
+// Solidity: function eip712Domain() view returns(bytes1 fields, string name, string version, uint256 chainId, address verifyingContract, bytes32 salt, uint256[] extensions)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) Eip712Domain() (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	return _LBBCertRoyalty.Contract.Eip712Domain(&_LBBCertRoyalty.CallOpts)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertRoyalty.Contract.GetApproved(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertRoyalty.Contract.GetApproved(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCertRoyalty.Contract.IsApprovedForAll(&_LBBCertRoyalty.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _LBBCertRoyalty.Contract.IsApprovedForAll(&_LBBCertRoyalty.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Name() (string, error) {
+	return _LBBCertRoyalty.Contract.Name(&_LBBCertRoyalty.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) Name() (string, error) {
+	return _LBBCertRoyalty.Contract.Name(&_LBBCertRoyalty.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "nonces", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Nonces(owner common.Address) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.Nonces(&_LBBCertRoyalty.CallOpts, owner)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) Nonces(owner common.Address) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.Nonces(&_LBBCertRoyalty.CallOpts, owner)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Owner() (common.Address, error) {
+	return _LBBCertRoyalty.Contract.Owner(&_LBBCertRoyalty.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) Owner() (common.Address, error) {
+	return _LBBCertRoyalty.Contract.Owner(&_LBBCertRoyalty.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertRoyalty.Contract.OwnerOf(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _LBBCertRoyalty.Contract.OwnerOf(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) RoyaltyInfo(opts *bind.CallOpts, tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "royaltyInfo", tokenId, salePrice)
+
+	outstruct := new(struct {
+		Receiver common.Address
+		Amount   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Receiver = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) RoyaltyInfo(tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _LBBCertRoyalty.Contract.RoyaltyInfo(&_LBBCertRoyalty.CallOpts, tokenId, salePrice)
+}
+
+// RoyaltyInfo is a free data retrieval call binding the contract method 0x2a55205a.
+//
+// Solidity: function royaltyInfo(uint256 tokenId, uint256 salePrice) view returns(address receiver, uint256 amount)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) RoyaltyInfo(tokenId *big.Int, salePrice *big.Int) (struct {
+	Receiver common.Address
+	Amount   *big.Int
+}, error) {
+	return _LBBCertRoyalty.Contract.RoyaltyInfo(&_LBBCertRoyalty.CallOpts, tokenId, salePrice)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCertRoyalty.Contract.SupportsInterface(&_LBBCertRoyalty.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _LBBCertRoyalty.Contract.SupportsInterface(&_LBBCertRoyalty.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Symbol() (string, error) {
+	return _LBBCertRoyalty.Contract.Symbol(&_LBBCertRoyalty.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) Symbol() (string, error) {
+	return _LBBCertRoyalty.Contract.Symbol(&_LBBCertRoyalty.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TokenByIndex(&_LBBCertRoyalty.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TokenByIndex(&_LBBCertRoyalty.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TokenOfOwnerByIndex(&_LBBCertRoyalty.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TokenOfOwnerByIndex(&_LBBCertRoyalty.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCertRoyalty.Contract.TokenURI(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _LBBCertRoyalty.Contract.TokenURI(&_LBBCertRoyalty.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _LBBCertRoyalty.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TotalSupply() (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TotalSupply(&_LBBCertRoyalty.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_LBBCertRoyalty *LBBCertRoyaltyCallerSession) TotalSupply() (*big.Int, error) {
+	return _LBBCertRoyalty.Contract.TotalSupply(&_LBBCertRoyalty.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Approve(&_LBBCertRoyalty.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Approve(&_LBBCertRoyalty.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Burn(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Burn(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) BurnWithPermit(opts *bind.TransactOpts, from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "burnWithPermit", from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.BurnWithPermit(&_LBBCertRoyalty.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// BurnWithPermit is a paid mutator transaction binding the contract method 0xf7dedf5e.
+//
+// Solidity: function burnWithPermit(address from, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) BurnWithPermit(from common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.BurnWithPermit(&_LBBCertRoyalty.TransactOpts, from, tokenId, deadline, v, r, s)
+}
+
+// DeleteDefaultRoyalty is a paid mutator transaction binding the contract method 0xaa1b103f.
+//
+// Solidity: function deleteDefaultRoyalty() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) DeleteDefaultRoyalty(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "deleteDefaultRoyalty")
+}
+
+// DeleteDefaultRoyalty is a paid mutator transaction binding the contract method 0xaa1b103f.
+//
+// Solidity: function deleteDefaultRoyalty() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) DeleteDefaultRoyalty() (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.DeleteDefaultRoyalty(&_LBBCertRoyalty.TransactOpts)
+}
+
+// DeleteDefaultRoyalty is a paid mutator transaction binding the contract method 0xaa1b103f.
+//
+// Solidity: function deleteDefaultRoyalty() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) DeleteDefaultRoyalty() (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.DeleteDefaultRoyalty(&_LBBCertRoyalty.TransactOpts)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) EmitBatchMetadataUpdate(opts *bind.TransactOpts, fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "emitBatchMetadataUpdate", fromTokenId, toTokenId)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) EmitBatchMetadataUpdate(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.EmitBatchMetadataUpdate(&_LBBCertRoyalty.TransactOpts, fromTokenId, toTokenId)
+}
+
+// EmitBatchMetadataUpdate is a paid mutator transaction binding the contract method 0xa4830114.
+//
+// Solidity: function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) EmitBatchMetadataUpdate(fromTokenId *big.Int, toTokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.EmitBatchMetadataUpdate(&_LBBCertRoyalty.TransactOpts, fromTokenId, toTokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) EmitMetadataUpdate(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "emitMetadataUpdate", tokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) EmitMetadataUpdate(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.EmitMetadataUpdate(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// EmitMetadataUpdate is a paid mutator transaction binding the contract method 0x3190b9ea.
+//
+// Solidity: function emitMetadataUpdate(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) EmitMetadataUpdate(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.EmitMetadataUpdate(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "permit", owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Permit(&_LBBCertRoyalty.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner, address spender, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) Permit(owner common.Address, spender common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.Permit(&_LBBCertRoyalty.TransactOpts, owner, spender, tokenId, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) PermitForAll(opts *bind.TransactOpts, owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "permitForAll", owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.PermitForAll(&_LBBCertRoyalty.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// PermitForAll is a paid mutator transaction binding the contract method 0x9032c726.
+//
+// Solidity: function permitForAll(address owner, address operator, bool approved, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) PermitForAll(owner common.Address, operator common.Address, approved bool, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.PermitForAll(&_LBBCertRoyalty.TransactOpts, owner, operator, approved, deadline, v, r, s)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.RenounceOwnership(&_LBBCertRoyalty.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.RenounceOwnership(&_LBBCertRoyalty.TransactOpts)
+}
+
+// ResetTokenRoyalty is a paid mutator transaction binding the contract method 0x8a616bc0.
+//
+// Solidity: function resetTokenRoyalty(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) ResetTokenRoyalty(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "resetTokenRoyalty", tokenId)
+}
+
+// ResetTokenRoyalty is a paid mutator transaction binding the contract method 0x8a616bc0.
+//
+// Solidity: function resetTokenRoyalty(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) ResetTokenRoyalty(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.ResetTokenRoyalty(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// ResetTokenRoyalty is a paid mutator transaction binding the contract method 0x8a616bc0.
+//
+// Solidity: function resetTokenRoyalty(uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) ResetTokenRoyalty(tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.ResetTokenRoyalty(&_LBBCertRoyalty.TransactOpts, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SafeMint(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "safeMint", to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeMint(&_LBBCertRoyalty.TransactOpts, to, tokenId)
+}
+
+// SafeMint is a paid mutator transaction binding the contract method 0xa1448194.
+//
+// Solidity: function safeMint(address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SafeMint(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeMint(&_LBBCertRoyalty.TransactOpts, to, tokenId)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SafeMintBatch(opts *bind.TransactOpts, to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "safeMintBatch", to, ids)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SafeMintBatch(to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeMintBatch(&_LBBCertRoyalty.TransactOpts, to, ids)
+}
+
+// SafeMintBatch is a paid mutator transaction binding the contract method 0xaef765e4.
+//
+// Solidity: function safeMintBatch(address[] to, uint256[] ids) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SafeMintBatch(to []common.Address, ids []*big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeMintBatch(&_LBBCertRoyalty.TransactOpts, to, ids)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeTransferFrom(&_LBBCertRoyalty.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeTransferFrom(&_LBBCertRoyalty.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeTransferFrom0(&_LBBCertRoyalty.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SafeTransferFrom0(&_LBBCertRoyalty.TransactOpts, from, to, tokenId, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetApprovalForAll(&_LBBCertRoyalty.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetApprovalForAll(&_LBBCertRoyalty.TransactOpts, operator, approved)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SetBaseURI(opts *bind.TransactOpts, baseURI string) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "setBaseURI", baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetBaseURI(&_LBBCertRoyalty.TransactOpts, baseURI)
+}
+
+// SetBaseURI is a paid mutator transaction binding the contract method 0x55f804b3.
+//
+// Solidity: function setBaseURI(string baseURI) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SetBaseURI(baseURI string) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetBaseURI(&_LBBCertRoyalty.TransactOpts, baseURI)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SetDefaultRoyalty(opts *bind.TransactOpts, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "setDefaultRoyalty", receiver, feeNumerator)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SetDefaultRoyalty(receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetDefaultRoyalty(&_LBBCertRoyalty.TransactOpts, receiver, feeNumerator)
+}
+
+// SetDefaultRoyalty is a paid mutator transaction binding the contract method 0x04634d8d.
+//
+// Solidity: function setDefaultRoyalty(address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SetDefaultRoyalty(receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetDefaultRoyalty(&_LBBCertRoyalty.TransactOpts, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) SetTokenRoyalty(opts *bind.TransactOpts, tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "setTokenRoyalty", tokenId, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) SetTokenRoyalty(tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetTokenRoyalty(&_LBBCertRoyalty.TransactOpts, tokenId, receiver, feeNumerator)
+}
+
+// SetTokenRoyalty is a paid mutator transaction binding the contract method 0x5944c753.
+//
+// Solidity: function setTokenRoyalty(uint256 tokenId, address receiver, uint96 feeNumerator) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) SetTokenRoyalty(tokenId *big.Int, receiver common.Address, feeNumerator *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.SetTokenRoyalty(&_LBBCertRoyalty.TransactOpts, tokenId, receiver, feeNumerator)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferFrom(&_LBBCertRoyalty.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferFrom(&_LBBCertRoyalty.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferOwnership(&_LBBCertRoyalty.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferOwnership(&_LBBCertRoyalty.TransactOpts, newOwner)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactor) TransferWithPermit(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.contract.Transact(opts, "transferWithPermit", from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltySession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferWithPermit(&_LBBCertRoyalty.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// TransferWithPermit is a paid mutator transaction binding the contract method 0x605629d6.
+//
+// Solidity: function transferWithPermit(address from, address to, uint256 tokenId, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_LBBCertRoyalty *LBBCertRoyaltyTransactorSession) TransferWithPermit(from common.Address, to common.Address, tokenId *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _LBBCertRoyalty.Contract.TransferWithPermit(&_LBBCertRoyalty.TransactOpts, from, to, tokenId, deadline, v, r, s)
+}
+
+// LBBCertRoyaltyApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyApprovalIterator struct {
+	Event *LBBCertRoyaltyApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyApproval represents a Approval event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*LBBCertRoyaltyApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyApprovalIterator{contract: _LBBCertRoyalty.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyApproval)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseApproval(log types.Log) (*LBBCertRoyaltyApproval, error) {
+	event := new(LBBCertRoyaltyApproval)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyApprovalForAllIterator struct {
+	Event *LBBCertRoyaltyApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyApprovalForAll represents a ApprovalForAll event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertRoyaltyApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyApprovalForAllIterator{contract: _LBBCertRoyalty.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyApprovalForAll)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseApprovalForAll(log types.Log) (*LBBCertRoyaltyApprovalForAll, error) {
+	event := new(LBBCertRoyaltyApprovalForAll)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyBatchMetadataUpdateIterator is returned from FilterBatchMetadataUpdate and is used to iterate over the raw logs and unpacked data for BatchMetadataUpdate events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyBatchMetadataUpdateIterator struct {
+	Event *LBBCertRoyaltyBatchMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyBatchMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyBatchMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyBatchMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyBatchMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyBatchMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyBatchMetadataUpdate represents a BatchMetadataUpdate event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyBatchMetadataUpdate struct {
+	FromTokenId *big.Int
+	ToTokenId   *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterBatchMetadataUpdate is a free log retrieval operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterBatchMetadataUpdate(opts *bind.FilterOpts) (*LBBCertRoyaltyBatchMetadataUpdateIterator, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyBatchMetadataUpdateIterator{contract: _LBBCertRoyalty.contract, event: "BatchMetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchBatchMetadataUpdate is a free log subscription operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchBatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyBatchMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "BatchMetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyBatchMetadataUpdate)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBatchMetadataUpdate is a log parse operation binding the contract event 0x6bd5c950a8d8df17f772f5af37cb3655737899cbf903264b9795592da439661c.
+//
+// Solidity: event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseBatchMetadataUpdate(log types.Log) (*LBBCertRoyaltyBatchMetadataUpdate, error) {
+	event := new(LBBCertRoyaltyBatchMetadataUpdate)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "BatchMetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyEIP712DomainChangedIterator is returned from FilterEIP712DomainChanged and is used to iterate over the raw logs and unpacked data for EIP712DomainChanged events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyEIP712DomainChangedIterator struct {
+	Event *LBBCertRoyaltyEIP712DomainChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyEIP712DomainChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyEIP712DomainChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyEIP712DomainChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyEIP712DomainChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyEIP712DomainChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyEIP712DomainChanged represents a EIP712DomainChanged event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyEIP712DomainChanged struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterEIP712DomainChanged is a free log retrieval operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterEIP712DomainChanged(opts *bind.FilterOpts) (*LBBCertRoyaltyEIP712DomainChangedIterator, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyEIP712DomainChangedIterator{contract: _LBBCertRoyalty.contract, event: "EIP712DomainChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEIP712DomainChanged is a free log subscription operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyEIP712DomainChanged) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "EIP712DomainChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyEIP712DomainChanged)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEIP712DomainChanged is a log parse operation binding the contract event 0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31.
+//
+// Solidity: event EIP712DomainChanged()
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseEIP712DomainChanged(log types.Log) (*LBBCertRoyaltyEIP712DomainChanged, error) {
+	event := new(LBBCertRoyaltyEIP712DomainChanged)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "EIP712DomainChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyMetadataUpdateIterator is returned from FilterMetadataUpdate and is used to iterate over the raw logs and unpacked data for MetadataUpdate events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyMetadataUpdateIterator struct {
+	Event *LBBCertRoyaltyMetadataUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyMetadataUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyMetadataUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyMetadataUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyMetadataUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyMetadataUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyMetadataUpdate represents a MetadataUpdate event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyMetadataUpdate struct {
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMetadataUpdate is a free log retrieval operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterMetadataUpdate(opts *bind.FilterOpts) (*LBBCertRoyaltyMetadataUpdateIterator, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyMetadataUpdateIterator{contract: _LBBCertRoyalty.contract, event: "MetadataUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchMetadataUpdate is a free log subscription operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchMetadataUpdate(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyMetadataUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "MetadataUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyMetadataUpdate)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMetadataUpdate is a log parse operation binding the contract event 0xf8e1a15aba9398e019f0b49df1a4fde98ee17ae345cb5f6b5e2c27f5033e8ce7.
+//
+// Solidity: event MetadataUpdate(uint256 _tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseMetadataUpdate(log types.Log) (*LBBCertRoyaltyMetadataUpdate, error) {
+	event := new(LBBCertRoyaltyMetadataUpdate)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "MetadataUpdate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyOwnershipTransferredIterator struct {
+	Event *LBBCertRoyaltyOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyOwnershipTransferred represents a OwnershipTransferred event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*LBBCertRoyaltyOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyOwnershipTransferredIterator{contract: _LBBCertRoyalty.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyOwnershipTransferred)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseOwnershipTransferred(log types.Log) (*LBBCertRoyaltyOwnershipTransferred, error) {
+	event := new(LBBCertRoyaltyOwnershipTransferred)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyPermitForAllUsedIterator is returned from FilterPermitForAllUsed and is used to iterate over the raw logs and unpacked data for PermitForAllUsed events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyPermitForAllUsedIterator struct {
+	Event *LBBCertRoyaltyPermitForAllUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyPermitForAllUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyPermitForAllUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyPermitForAllUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyPermitForAllUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyPermitForAllUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyPermitForAllUsed represents a PermitForAllUsed event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyPermitForAllUsed struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitForAllUsed is a free log retrieval operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterPermitForAllUsed(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*LBBCertRoyaltyPermitForAllUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyPermitForAllUsedIterator{contract: _LBBCertRoyalty.contract, event: "PermitForAllUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitForAllUsed is a free log subscription operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchPermitForAllUsed(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyPermitForAllUsed, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "PermitForAllUsed", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyPermitForAllUsed)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitForAllUsed is a log parse operation binding the contract event 0x03e33ac53cf9c69eb8f73754e40036934f96370bf598adba9d82231ddb9b0477.
+//
+// Solidity: event PermitForAllUsed(address indexed owner, address indexed operator, bool approved)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParsePermitForAllUsed(log types.Log) (*LBBCertRoyaltyPermitForAllUsed, error) {
+	event := new(LBBCertRoyaltyPermitForAllUsed)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "PermitForAllUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyPermitUsedIterator is returned from FilterPermitUsed and is used to iterate over the raw logs and unpacked data for PermitUsed events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyPermitUsedIterator struct {
+	Event *LBBCertRoyaltyPermitUsed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyPermitUsedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyPermitUsed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyPermitUsed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyPermitUsedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyPermitUsedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyPermitUsed represents a PermitUsed event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyPermitUsed struct {
+	Owner   common.Address
+	Spender common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterPermitUsed is a free log retrieval operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterPermitUsed(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*LBBCertRoyaltyPermitUsedIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyPermitUsedIterator{contract: _LBBCertRoyalty.contract, event: "PermitUsed", logs: logs, sub: sub}, nil
+}
+
+// WatchPermitUsed is a free log subscription operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchPermitUsed(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyPermitUsed, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "PermitUsed", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyPermitUsed)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePermitUsed is a log parse operation binding the contract event 0x1de9b1f4277253dc9ecd0dcf53c796ecb43ff6fa66d9f49e0ac00c8ed2dd4326.
+//
+// Solidity: event PermitUsed(address indexed owner, address indexed spender, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParsePermitUsed(log types.Log) (*LBBCertRoyaltyPermitUsed, error) {
+	event := new(LBBCertRoyaltyPermitUsed)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "PermitUsed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltyTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyTransferIterator struct {
+	Event *LBBCertRoyaltyTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltyTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltyTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltyTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltyTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltyTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltyTransfer represents a Transfer event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltyTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*LBBCertRoyaltyTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltyTransferIterator{contract: _LBBCertRoyalty.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltyTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltyTransfer)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseTransfer(log types.Log) (*LBBCertRoyaltyTransfer, error) {
+	event := new(LBBCertRoyaltyTransfer)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// LBBCertRoyaltySafeMintEventIterator is returned from FilterSafeMintEvent and is used to iterate over the raw logs and unpacked data for SafeMintEvent events raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltySafeMintEventIterator struct {
+	Event *LBBCertRoyaltySafeMintEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *LBBCertRoyaltySafeMintEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(LBBCertRoyaltySafeMintEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(LBBCertRoyaltySafeMintEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *LBBCertRoyaltySafeMintEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *LBBCertRoyaltySafeMintEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// LBBCertRoyaltySafeMintEvent represents a SafeMintEvent event raised by the LBBCertRoyalty contract.
+type LBBCertRoyaltySafeMintEvent struct {
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeMintEvent is a free log retrieval operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) FilterSafeMintEvent(opts *bind.FilterOpts) (*LBBCertRoyaltySafeMintEventIterator, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.FilterLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return &LBBCertRoyaltySafeMintEventIterator{contract: _LBBCertRoyalty.contract, event: "safeMintEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeMintEvent is a free log subscription operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) WatchSafeMintEvent(opts *bind.WatchOpts, sink chan<- *LBBCertRoyaltySafeMintEvent) (event.Subscription, error) {
+
+	logs, sub, err := _LBBCertRoyalty.contract.WatchLogs(opts, "safeMintEvent")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(LBBCertRoyaltySafeMintEvent)
+				if err := _LBBCertRoyalty.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeMintEvent is a log parse operation binding the contract event 0x73c47b7ba8d1fdddd2aac143ee67f26ed6b5002135fb6b736f0a98b549921ad3.
+//
+// Solidity: event safeMintEvent(address to, uint256 tokenId)
+func (_LBBCertRoyalty *LBBCertRoyaltyFilterer) ParseSafeMintEvent(log types.Log) (*LBBCertRoyaltySafeMintEvent, error) {
+	event := new(LBBCertRoyaltySafeMintEvent)
+	if err := _LBBCertRoyalty.contract.UnpackLog(event, "safeMintEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: MIT
+// Compatible with OpenZeppelin Contracts ^5.5.0
+pragma solidity ^0.8.20;
+
+import {ERC721Upgradeable} from "openzeppelin-contracts-upgradeable/token/ERC721/ERC721Upgradeable.sol";
+import {ERC721EnumerableUpgradeable} from "openzeppelin-contracts-upgradeable/token/ERC721/extensions/ERC721EnumerableUpgradeable.sol";
+import {ERC721BurnableUpgradeable} from "openzeppelin-contracts-upgradeable/token/ERC721/extensions/ERC721BurnableUpgradeable.sol";
+import {OwnableUpgradeable} from "openzeppelin-contracts-upgradeable/access/OwnableUpgradeable.sol";
+import {EIP712Upgradeable} from "openzeppelin-contracts-upgradeable/utils/cryptography/EIP712Upgradeable.sol";
+import {Initializable} from "openzeppelin-contracts-upgradeable/proxy/utils/Initializable.sol";
+import {UUPSUpgradeable} from "openzeppelin-contracts-upgradeable/proxy/utils/UUPSUpgradeable.sol";
+import {ERC1967Proxy} from "openzeppelin-contracts/proxy/ERC1967/ERC1967Proxy.sol";
+import {Strings} from "openzeppelin-contracts/utils/Strings.sol";
+import {ECDSA} from "openzeppelin-contracts/utils/cryptography/ECDSA.sol";
+
+error NonExistentTokenURI();
+error InvalidSignature();
+error SignatureExpired();
+error InvalidSigner();
+error BatchLengthMismatch(uint256 recipients, uint256 tokenIds);
+
+/**
+ * @dev LBBCert behind a UUPS proxy, so a bug fix keeps the contract address and every token.
+ *
+ * The implementation is constructed with initialize instead of a constructor, through the proxy.
+ * Only the owner can upgrade. State variables of this contract must keep their order and type
+ * across versions and new ones go after them, the SDK checks this against the recorded layout in
+ * contracts/layout/LBBCertUpgradeable.json before upgrading.
+ */
+contract LBBCertUpgradeable is
+    Initializable,
+    ERC721Upgradeable,
+    ERC721EnumerableUpgradeable,
+    ERC721BurnableUpgradeable,
+    OwnableUpgradeable,
+    EIP712Upgradeable,
+    UUPSUpgradeable
+{
+    using Strings for uint256;
+    string private _baseTokenURI;
+
+    // Nonces for permit
+    mapping(address => uint256) private _nonces;
+
+    // EIP-712 Type Hashes
+    bytes32 private constant PERMIT_TYPEHASH =
+        keccak256(
+            "Permit(address owner,address spender,uint256 tokenId,uint256 nonce,uint256 deadline)"
+        );
+
+    bytes32 private constant PERMIT_FOR_ALL_TYPEHASH =
+        keccak256(
+            "PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"
+        );
+
+    // Interface ID of ERC-4906, which has no functions of its own
+    bytes4 private constant ERC4906_INTERFACE_ID = bytes4(0x49064906);
+
+    // EVENTS
+    event MetadataUpdate(uint256 _tokenId);
+    event BatchMetadataUpdate(uint256 _fromTokenId, uint256 _toTokenId);
+    event safeMintEvent(address to, uint256 tokenId);
+    event PermitUsed(
+        address indexed owner,
+        address indexed spender,
+        uint256 tokenId
+    );
+    event PermitForAllUsed(
+        address indexed owner,
+        address indexed operator,
+        bool approved
+    );
+
+    /// @custom:oz-upgrades-unsafe-allow constructor
+    constructor() {
+        _disableInitializers();
+    }
+
+    function initialize(
+        string memory name,
+        string memory symbol,
+        string memory baseURI,
+        address initialOwner
+    ) public initializer {
+        __ERC721_init(name, symbol);
+        __ERC721Enumerable_init();
+        __ERC721Burnable_init();
+        __Ownable_init(initialOwner);
+        __EIP712_init(name, "1");
+        __UUPSUpgradeable_init();
+        _baseTokenURI = baseURI;
+    }
+
+    function safeMint(address to, uint256 tokenId) public onlyOwner {
+        _safeMint(to, tokenId);
+    }
+
+    /**
+     * @dev Mints ids[i] to to[i] in one transaction, the whole batch reverts if any ID is minted
+     */
+    function safeMintBatch(address[] calldata to, uint256[] calldata ids) public onlyOwner {
+        if (to.length != ids.length) {
+            revert BatchLengthMismatch(to.length, ids.length);
+        }
+        for (uint256 i = 0; i < ids.length; i++) {
+            _safeMint(to[i], ids[i]);
+        }
+    }
+
+    // BASE URI
+    function _baseURI() internal view virtual override returns (string memory) {
+        return _baseTokenURI;
+    }
+
+    function setBaseURI(string calldata baseURI) external onlyOwner {
+        _baseTokenURI = baseURI;
+        emit BatchMetadataUpdate(0, type(uint256).max);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of tokenId, see ERC-4906
+     */
+    function emitMetadataUpdate(uint256 tokenId) external onlyOwner {
+        _requireOwned(tokenId);
+        emit MetadataUpdate(tokenId);
+    }
+
+    /**
+     * @dev Tells marketplaces and indexers to refetch the tokenURI of every token in the range
+     */
+    function emitBatchMetadataUpdate(uint256 fromTokenId, uint256 toTokenId) external onlyOwner {
+        emit BatchMetadataUpdate(fromTokenId, toTokenId);
+    }
+
+    function tokenURI(
+        uint256 tokenId
+    ) public view virtual override returns (string memory) {
+        if (ownerOf(tokenId) == address(0)) {
+            revert NonExistentTokenURI();
+        }
+        return
+            bytes(_baseTokenURI).length > 0
+                ? string(abi.encodePacked(_baseTokenURI, tokenId.toString()))
+                : "";
+    }
+
+    // ============ EIP-2612 Style Permit Functions ============
+
+    /**
+     * @dev Returns the current nonce for `owner`. This value must be included in the signature.
+     */
+    function nonces(address owner) public view returns (uint256) {
+        return _nonces[owner];
+    }
+
+    /**
+     * @dev Returns the domain separator for the current chain.
+     */
+    function DOMAIN_SEPARATOR() external view returns (bytes32) {
+        return _domainSeparatorV4();
+    }
+
+    /**
+     * @dev Permit approval for a specific token using EIP-712 signature
+     * @param owner The owner of the token
+     * @param spender The address to approve
+     * @param tokenId The token ID to approve
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function permit(
+        address owner,
+        address spender,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        if (block.timestamp > deadline) {
+            revert SignatureExpired();
+        }
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_TYPEHASH,
+                owner,
+                spender,
+                tokenId,
+                _nonces[owner]++,
+                deadline
+            )
+        );
+
+        bytes32 hash = _hashTypedDataV4(structHash);
+        address signer = ECDSA.recover(hash, v, r, s);
+
+        if (signer != owner) {
+            revert InvalidSigner();
+        }
+
+        if (ownerOf(tokenId) != owner) {
+            revert InvalidSigner();
+        }
+
+        _approve(spender, tokenId, owner);
+        emit PermitUsed(owner, spender, tokenId);
+    }
+
+    /**
+     * @dev Permit approval for all tokens using EIP-712 signature (setApprovalForAll)
+     * @param owner The owner granting approval
+     * @param operator The operator to approve/revoke
+     * @param approved Whether to approve or revoke
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function permitForAll(
+        address owner,
+        address operator,
+        bool approved,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        if (block.timestamp > deadline) {
+            revert SignatureExpired();
+        }
+
+        bytes32 structHash = keccak256(
+            abi.encode(
+                PERMIT_FOR_ALL_TYPEHASH,
+                owner,
+                operator,
+                approved,
+                _nonces[owner]++,
+                deadline
+            )
+        );
+
+        bytes32 hash = _hashTypedDataV4(structHash);
+        address signer = ECDSA.recover(hash, v, r, s);
+
+        if (signer != owner) {
+            revert InvalidSigner();
+        }
+
+        _setApprovalForAll(owner, operator, approved);
+        emit PermitForAllUsed(owner, operator, approved);
+    }
+
+    /**
+     * @dev Transfer token using permit signature (gasless transfer)
+     * @param from The current owner
+     * @param to The recipient
+     * @param tokenId The token ID to transfer
+     * @param deadline The deadline timestamp for the signature
+     * @param v The recovery byte of the signature
+     * @param r Half of the ECDSA signature
+     * @param s Half of the ECDSA signature
+     */
+    function transferWithPermit(
+        address from,
+        address to,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        // First, validate the permit and approve msg.sender
+        permit(from, msg.sender, tokenId, deadline, v, r, s);
+
+        // Then transfer the token
+        safeTransferFrom(from, to, tokenId);
+    }
+
+    function burnWithPermit(
+        address from,
+        uint256 tokenId,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) public {
+        permit(from, msg.sender, tokenId, deadline, v, r, s);
+
+        burn(tokenId);
+    }
+
+    // ============ End of Permit Functions ============
+
+    /**
+     * @dev Only the owner may upgrade the implementation behind the proxy
+     */
+    function _authorizeUpgrade(address) internal override onlyOwner {}
+
+    // The following functions are overrides required by Solidity.
+
+    function _update(
+        address to,
+        uint256 tokenId,
+        address auth
+    ) internal override(ERC721Upgradeable, ERC721EnumerableUpgradeable) returns (address) {
+        return super._update(to, tokenId, auth);
+    }
+
+    function _increaseBalance(
+        address account,
+        uint128 value
+    ) internal override(ERC721Upgradeable, ERC721EnumerableUpgradeable) {
+        super._increaseBalance(account, value);
+    }
+
+    function supportsInterface(
+        bytes4 interfaceId
+    ) public view override(ERC721Upgradeable, ERC721EnumerableUpgradeable) returns (bool) {
+        return interfaceId == ERC4906_INTERFACE_ID || super.supportsInterface(interfaceId);
+    }
+}
+
+/**
+ * @dev ERC-1967 proxy of LBBCertUpgradeable, data is the encoded initialize call
+ */
+contract LBBCertProxy is ERC1967Proxy {
+    constructor(address implementation, bytes memory data) ERC1967Proxy(implementation, data) {}
+}
@@ -0,0 +1,213 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/certv2"
+)
+
+// Type hashes of the structs LBBCertV2 verifies, its nonces are kept per owner under these purposes
+var (
+	PermitTypeHash         = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 tokenId,uint256 nonce,uint256 deadline)"))
+	PermitForAllTypeHash   = crypto.Keccak256Hash([]byte("PermitForAll(address owner,address operator,bool approved,uint256 nonce,uint256 deadline)"))
+	TransferPermitTypeHash = crypto.Keccak256Hash([]byte("TransferPermit(address owner,address to,uint256 tokenId,uint256 nonce,uint256 deadline)"))
+)
+
+// ERC1271MagicValue is what isValidSignature of a smart-contract wallet returns for a valid signature
+var ERC1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+// ERC1271MetaData holds the ABI of the EIP-1271 isValidSignature function
+var ERC1271MetaData = &bind.MetaData{
+	ABI: `[{"type":"function","name":"isValidSignature","stateMutability":"view","inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],"outputs":[{"name":"magicValue","type":"bytes4"}]}]`,
+}
+
+// IsValidSignatureNow checks a signature of hash by signer the way LBBCertV2 does with SignatureChecker
+// A signer without code must have signed with its key, a signer with code is asked through EIP-1271
+func IsValidSignatureNow(ctx context.Context, caller bind.ContractCaller, signer common.Address, hash []byte, signature []byte) (bool, error) {
+	code, err := caller.CodeAt(ctx, signer, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get code of %s: %w", signer.Hex(), err)
+	}
+
+	if len(code) == 0 {
+		permitSignature, err := permitSignatureFromBytes(signature)
+		if err != nil {
+			return false, nil
+		}
+
+		// A signature that recovers no key is invalid, not an error, as in the contract
+		valid, err := VerifyPermitSignature(signer, permitSignature, hash)
+		return valid && err == nil, nil
+	}
+
+	erc1271ABI, err := ERC1271MetaData.GetAbi()
+	if err != nil {
+		return false, err
+	}
+
+	data, err := erc1271ABI.Pack("isValidSignature", common.BytesToHash(hash), signature)
+	if err != nil {
+		return false, err
+	}
+
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &signer, Data: data}, nil)
+	if err != nil {
+		// A wallet that reverts rejects the signature
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to call isValidSignature on %s: %w", signer.Hex(), err)
+	}
+
+	return len(out) == 32 && bytes.Equal(out[:4], ERC1271MagicValue[:]), nil
+}
+
+// IsValidSignature checks a signature against the current code of signer, see IsValidSignatureNow
+func (e *EVMClient) IsValidSignature(signer common.Address, hash []byte, signature []byte) (bool, error) {
+	return IsValidSignatureNow(e.GetClient().GetContext(), e.Backend(), signer, hash, signature)
+}
+
+// DeployCertificateContractV2 deploys LBBCertV2 from a forge artifact of contracts/src/CertV2.sol
+// The SDK does not bundle the v2 bytecode, build it with `forge build` and pass out/CertV2.sol/LBBCertV2.json
+func (e *EVMClient) DeployCertificateContractV2(artifactPath, contractName, symbol, nftSchemaCode string) (common.Address, *types.Transaction, error) {
+	return e.deployFromArtifact(artifactPath, certv2.LBBCertV2MetaData, contractName, symbol, e.certificateBaseURI(nftSchemaCode), e.GetEVMAddress())
+}
+
+// deployFromArtifact deploys the bytecode of a forge artifact, packing the constructor arguments with the ABI of metaData
+func (e *EVMClient) deployFromArtifact(artifactPath string, metaData *bind.MetaData, params ...interface{}) (common.Address, *types.Transaction, error) {
+	artifact, err := LoadArtifact(artifactPath)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if len(artifact.Bytecode) == 0 {
+		return common.Address{}, nil, fmt.Errorf("%s has no bytecode", artifactPath)
+	}
+
+	contractABI, err := metaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	address, tx, _, err := bind.DeployContract(opts, *contractABI, artifact.Bytecode, e.Backend(), params...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	return address, tx, nil
+}
+
+// SignatureNonce gets the nonce of owner for purpose from a v2 certificate contract
+// Purpose is PermitTypeHash, PermitForAllTypeHash or TransferPermitTypeHash
+func (e *EVMClient) SignatureNonce(contractAddress common.Address, owner common.Address, purpose common.Hash) (*big.Int, error) {
+	contract, err := e.LBBCertV2(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	nonce, err := contract.Nonces(e.CallOpts(), owner, purpose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call nonces: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// SignTransferPermit creates an EIP-712 signature that lets anyone move tokenID to `to` and nowhere else
+// The chain ID and nonce are read over RPC, use SignTransferPermitOffline on a device without a client
+func (e *EVMClient) SignTransferPermit(
+	contractName string,
+	contractAddress common.Address,
+	to common.Address,
+	tokenID *big.Int,
+	deadline *big.Int,
+) (*PermitSignature, error) {
+	chainID, err := e.ChainID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	nonce, err := e.SignatureNonce(contractAddress, e.GetEVMAddress(), TransferPermitTypeHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	return SignTransferPermitOffline(NewKeySigner(e.GetPrivateKey()), NewPermitDomain(contractName, chainID, contractAddress), TransferPermit{
+		Owner:    e.GetEVMAddress(),
+		To:       to,
+		TokenID:  tokenID,
+		Nonce:    nonce,
+		Deadline: deadline,
+	})
+}
+
+// TransferWithSignature broadcasts transferWithSignature of a v2 certificate contract, the broadcaster pays for gas
+// Signature is the 65 byte signature of an EOA owner, see PermitSignature.Bytes, or the EIP-1271 data of a wallet contract
+func (e *EVMClient) TransferWithSignature(
+	contractAddress common.Address,
+	permit TransferPermit,
+	signature []byte,
+) (*types.Transaction, error) {
+	if err := permit.validate(); err != nil {
+		return nil, err
+	}
+	if len(signature) == 0 {
+		return nil, fmt.Errorf("transfer permit is not signed")
+	}
+	if err := e.requireTransferable(contractAddress, permit.TokenID); err != nil {
+		return nil, err
+	}
+
+	contract, err := e.LBBCertV2(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.TransferWithSignature(opts, permit.Owner, permit.To, permit.TokenID, permit.Deadline, signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transferWithSignature: %w", err)
+	}
+
+	return tx, nil
+}
+
+// CancelSignatureNonce invalidates every signature of this account for purpose that uses the current nonce
+func (e *EVMClient) CancelSignatureNonce(contractAddress common.Address, purpose common.Hash) (*types.Transaction, error) {
+	contract, err := e.LBBCertV2(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.CancelNonce(opts, purpose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send cancelNonce: %w", err)
+	}
+
+	return tx, nil
+}
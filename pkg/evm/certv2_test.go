@@ -0,0 +1,228 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/certv2"
+)
+
+// transferPermitDigest hashes a TransferPermit the way LBBCertV2 does with abi.encode and _hashTypedDataV4
+func transferPermitDigest(domain evm.EIP712Domain, permit evm.TransferPermit) []byte {
+	word := func(value *big.Int) []byte {
+		return math.U256Bytes(new(big.Int).Set(value))
+	}
+
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(domain.Name)),
+		crypto.Keccak256([]byte(domain.Version)),
+		word(domain.ChainID),
+		common.LeftPadBytes(domain.VerifyingContract.Bytes(), 32),
+	)
+
+	structHash := crypto.Keccak256(
+		evm.TransferPermitTypeHash.Bytes(),
+		common.LeftPadBytes(permit.Owner.Bytes(), 32),
+		common.LeftPadBytes(permit.To.Bytes(), 32),
+		word(permit.TokenID),
+		word(permit.Nonce),
+		word(permit.Deadline),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// fakeWallet answers CodeAt and isValidSignature calls like an EIP-1271 smart-contract wallet owned by a key
+type fakeWallet struct {
+	bind.ContractCaller
+	t       *testing.T
+	address common.Address
+	owner   common.Address
+	reverts bool
+}
+
+func (f *fakeWallet) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	if account == f.address {
+		return []byte{0x60, 0x80}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeWallet) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	require.Equal(f.t, f.address, *call.To)
+	if f.reverts {
+		return nil, revertRPCError{}
+	}
+
+	walletABI, err := evm.ERC1271MetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method := walletABI.Methods["isValidSignature"]
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(f.t, err)
+
+	hash := args[0].([32]byte)
+	valid, err := evm.IsValidSignatureNow(context.Background(), f, f.owner, hash[:], args[1].([]byte))
+	require.NoError(f.t, err)
+
+	magic := [4]byte{}
+	if valid {
+		magic = evm.ERC1271MagicValue
+	}
+	return method.Outputs.Pack(magic)
+}
+
+func TestTransferPermit(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := evm.NewKeySigner(key)
+
+	domain := evm.NewPermitDomain("MyNFTCert", big.NewInt(150), common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314"))
+	permit := evm.TransferPermit{
+		Owner:    signer.Address(),
+		To:       common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"),
+		TokenID:  big.NewInt(7),
+		Nonce:    big.NewInt(0),
+		Deadline: big.NewInt(1_900_000_000),
+	}
+
+	hash, err := permit.Hash(domain)
+	require.NoError(t, err)
+	assert.Equal(t, transferPermitDigest(domain, permit), hash)
+
+	signature, err := evm.SignTransferPermitOffline(signer, domain, permit)
+	require.NoError(t, err)
+	assert.Equal(t, permit.Deadline, signature.Deadline)
+
+	caller := &fakeWallet{t: t}
+	valid, err := evm.IsValidSignatureNow(context.Background(), caller, permit.Owner, hash, signature.Bytes())
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	t.Run("Binds the recipient", func(t *testing.T) {
+		redirected := permit
+		redirected.To = common.HexToAddress("0x01")
+
+		redirectedHash, err := redirected.Hash(domain)
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, redirectedHash)
+
+		valid, err := evm.IsValidSignatureNow(context.Background(), caller, permit.Owner, redirectedHash, signature.Bytes())
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("Differs from a Permit to the same address", func(t *testing.T) {
+		permitHash, err := domain.PermitHash(permit.Owner, permit.To, permit.TokenID, permit.Nonce, permit.Deadline)
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, permitHash)
+	})
+
+	t.Run("Invalid permits", func(t *testing.T) {
+		missing := permit
+		missing.To = common.Address{}
+		_, err := evm.SignTransferPermitOffline(signer, domain, missing)
+		assert.ErrorContains(t, err, "zero address")
+
+		missing = permit
+		missing.Nonce = nil
+		_, err = evm.SignTransferPermitOffline(signer, domain, missing)
+		assert.Error(t, err)
+
+		other := permit
+		other.Owner = permit.To
+		_, err = evm.SignTransferPermitOffline(signer, domain, other)
+		assert.ErrorContains(t, err, "not the permit owner")
+	})
+
+	t.Run("Envelope", func(t *testing.T) {
+		envelope, err := evm.NewTransferPermitEnvelope(domain, permit)
+		require.NoError(t, err)
+		require.NoError(t, envelope.Sign(signer))
+		require.NoError(t, envelope.Verify())
+		assert.Equal(t, signature.Bytes(), []byte(envelope.Signature))
+
+		decoded, err := envelope.TransferPermit()
+		require.NoError(t, err)
+		assert.Equal(t, permit, *decoded)
+
+		_, err = envelope.Permit()
+		assert.Error(t, err)
+	})
+
+	t.Run("Contract ABI", func(t *testing.T) {
+		contractABI, err := certv2.LBBCertV2MetaData.GetAbi()
+		require.NoError(t, err)
+
+		assert.Equal(t, "transferWithSignature(address,address,uint256,uint256,bytes)", contractABI.Methods["transferWithSignature"].Sig)
+		assert.Equal(t, "nonces(address,bytes32)", contractABI.Methods["nonces"].Sig)
+		assert.NotContains(t, contractABI.Methods, "transferWithPermit")
+	})
+}
+
+func TestIsValidSignatureNow(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := evm.NewKeySigner(key)
+
+	wallet := &fakeWallet{
+		t:       t,
+		address: common.HexToAddress("0x0000000000000000000000000000000000001271"),
+		owner:   signer.Address(),
+	}
+
+	hash := crypto.Keccak256([]byte("certificate"))
+	signature, err := signer.SignHash(hash)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		signer    common.Address
+		hash      []byte
+		signature []byte
+		reverts   bool
+		valid     bool
+	}{
+		{name: "EOA", signer: signer.Address(), hash: hash, signature: signature, valid: true},
+		{name: "EOA wrong hash", signer: signer.Address(), hash: crypto.Keccak256([]byte("other")), signature: signature},
+		{name: "EOA short signature", signer: signer.Address(), hash: hash, signature: signature[:64]},
+		{name: "Wallet signed by its owner", signer: wallet.address, hash: hash, signature: signature, valid: true},
+		{name: "Wallet wrong hash", signer: wallet.address, hash: crypto.Keccak256([]byte("other")), signature: signature},
+		{name: "Wallet reverts", signer: wallet.address, hash: hash, signature: signature, reverts: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet.reverts = tt.reverts
+
+			valid, err := evm.IsValidSignatureNow(context.Background(), wallet, tt.signer, tt.hash, tt.signature)
+			require.NoError(t, err)
+			assert.Equal(t, tt.valid, valid)
+		})
+	}
+
+	t.Run("RPC failure", func(t *testing.T) {
+		_, err := evm.IsValidSignatureNow(context.Background(), failingCaller{}, signer.Address(), hash, signature)
+		assert.Error(t, err)
+	})
+}
+
+type failingCaller struct {
+	bind.ContractCaller
+}
+
+func (failingCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return nil, errors.New("connection refused")
+}
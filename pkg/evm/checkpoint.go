@@ -0,0 +1,135 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CheckpointStore persists the last block an Indexer has fully processed
+// Keys identify an indexer so one store can serve several of them
+type CheckpointStore interface {
+	LoadCheckpoint(key string) (block uint64, found bool, err error)
+	SaveCheckpoint(key string, block uint64) error
+}
+
+var (
+	_ CheckpointStore = (*MemoryCheckpointStore)(nil)
+	_ CheckpointStore = (*FileCheckpointStore)(nil)
+)
+
+// MemoryCheckpointStore keeps checkpoints in memory, they are lost when the process exits
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]uint64
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		checkpoints: make(map[string]uint64),
+	}
+}
+
+func (s *MemoryCheckpointStore) LoadCheckpoint(key string) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block, found := s.checkpoints[key]
+	return block, found, nil
+}
+
+func (s *MemoryCheckpointStore) SaveCheckpoint(key string, block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[key] = block
+	return nil
+}
+
+// FileCheckpointStore keeps checkpoints in a JSON file
+// Every save rewrites the file through a temporary file and a rename so a crash never leaves it half written
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileCheckpointStore(path string) (*FileCheckpointStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("checkpoint file path cannot be empty")
+	}
+
+	return &FileCheckpointStore{
+		path: path,
+	}, nil
+}
+
+func (s *FileCheckpointStore) LoadCheckpoint(key string) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return 0, false, err
+	}
+
+	block, found := checkpoints[key]
+	return block, found, nil
+}
+
+func (s *FileCheckpointStore) SaveCheckpoint(key string, block uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = block
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoints: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write checkpoint file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write checkpoint file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write checkpoint file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace checkpoint file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileCheckpointStore) read() (map[string]uint64, error) {
+	checkpoints := make(map[string]uint64)
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed to decode checkpoint file %s: %w", s.path, err)
+	}
+
+	return checkpoints, nil
+}
@@ -0,0 +1,200 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/certv2"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/factory"
+	incrementassets "github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/increment"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/roles"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/royalty"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/soulbound"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/upgradeable"
+)
+
+// bufferedBackend adds the same 20% safety margin as GasLimit to every gas estimate made by the bindings
+type bufferedBackend struct {
+	bind.ContractBackend
+}
+
+func (b bufferedBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	gasLimit, err := b.ContractBackend.EstimateGas(ctx, call)
+	if err != nil {
+		return gasLimit, err
+	}
+	return gasLimit * 120 / 100, nil
+}
+
+// Backend returns the contract backend used by the typed bindings
+func (e *EVMClient) Backend() bind.ContractBackend {
+	return bufferedBackend{ContractBackend: e.GetClient().GetETHClient()}
+}
+
+// CallOpts returns call options for read-only contract calls made from this account
+func (e *EVMClient) CallOpts() *bind.CallOpts {
+	return &bind.CallOpts{
+		Context: e.GetClient().GetContext(),
+		From:    e.GetEVMAddress(),
+	}
+}
+
+// TransactOpts returns fresh transaction options signed by this account's EVM key and priced by the fee strategy
+// The nonce and gas limit are left empty so the bindings fill them in when the transaction is sent
+func (e *EVMClient) TransactOpts() (*bind.TransactOpts, error) {
+	chainID, err := e.ChainID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(e.GetPrivateKey(), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := e.Fees()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Context = e.GetClient().GetContext()
+	fees.Apply(opts)
+
+	return opts, nil
+}
+
+// signOnlyOpts returns transaction options that sign the transaction without broadcasting it
+func (e *EVMClient) signOnlyOpts() (*bind.TransactOpts, error) {
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	opts.NoSend = true
+	return opts, nil
+}
+
+// LBBCert returns a typed binding to a certificate contract
+// The embedded LBBCertCaller, LBBCertTransactor and LBBCertFilterer reach every contract function and event
+func (e *EVMClient) LBBCert(contractAddress common.Address) (*assets.LBBCert, error) {
+	return assets.NewLBBCert(contractAddress, e.Backend())
+}
+
+// LBBCertSession returns a certificate contract binding with call and transaction options of this account preset
+func (e *EVMClient) LBBCertSession(contractAddress common.Address) (*assets.LBBCertSession, error) {
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &assets.LBBCertSession{
+		Contract:     contract,
+		CallOpts:     *e.CallOpts(),
+		TransactOpts: *opts,
+	}, nil
+}
+
+// CertAutoID returns a typed binding to an auto-incrementing certificate contract
+func (e *EVMClient) CertAutoID(contractAddress common.Address) (*incrementassets.CertAutoID, error) {
+	return incrementassets.NewCertAutoID(contractAddress, e.Backend())
+}
+
+// CertAutoIDSession returns an auto-incrementing certificate contract binding with the options of this account preset
+func (e *EVMClient) CertAutoIDSession(contractAddress common.Address) (*incrementassets.CertAutoIDSession, error) {
+	contract, err := e.CertAutoID(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &incrementassets.CertAutoIDSession{
+		Contract:     contract,
+		CallOpts:     *e.CallOpts(),
+		TransactOpts: *opts,
+	}, nil
+}
+
+// LBBCertV2 returns a typed binding to a v2 certificate contract with recipient-bound transfer permits
+func (e *EVMClient) LBBCertV2(contractAddress common.Address) (*certv2.LBBCertV2, error) {
+	return certv2.NewLBBCertV2(contractAddress, e.Backend())
+}
+
+// LBBCertV2Session returns a v2 certificate contract binding with the options of this account preset
+func (e *EVMClient) LBBCertV2Session(contractAddress common.Address) (*certv2.LBBCertV2Session, error) {
+	contract, err := e.LBBCertV2(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &certv2.LBBCertV2Session{
+		Contract:     contract,
+		CallOpts:     *e.CallOpts(),
+		TransactOpts: *opts,
+	}, nil
+}
+
+// LBBCertSoulbound returns a typed binding to a soulbound certificate contract
+func (e *EVMClient) LBBCertSoulbound(contractAddress common.Address) (*soulbound.LBBCertSoulbound, error) {
+	return soulbound.NewLBBCertSoulbound(contractAddress, e.Backend())
+}
+
+// LBBCertRoles returns a typed binding to a role-based certificate contract
+func (e *EVMClient) LBBCertRoles(contractAddress common.Address) (*roles.LBBCertRoles, error) {
+	return roles.NewLBBCertRoles(contractAddress, e.Backend())
+}
+
+// LBBCertRoyalty returns a typed binding to a certificate contract with ERC-2981 royalties
+func (e *EVMClient) LBBCertRoyalty(contractAddress common.Address) (*royalty.LBBCertRoyalty, error) {
+	return royalty.NewLBBCertRoyalty(contractAddress, e.Backend())
+}
+
+// LBBCertFactory returns a typed binding to a CREATE2 certificate factory
+func (e *EVMClient) LBBCertFactory(factoryAddress common.Address) (*factory.LBBCertFactory, error) {
+	return factory.NewLBBCertFactory(factoryAddress, e.Backend())
+}
+
+// LBBCertUpgradeable returns a typed binding to an upgradeable certificate contract, at the address of its proxy
+func (e *EVMClient) LBBCertUpgradeable(proxyAddress common.Address) (*upgradeable.LBBCertUpgradeable, error) {
+	return upgradeable.NewLBBCertUpgradeable(proxyAddress, e.Backend())
+}
+
+// SupportsInterface reports whether a contract implements an ERC-165 interface
+// A contract without ERC-165 reverts, it supports no interface
+func SupportsInterface(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, interfaceID [4]byte) (bool, error) {
+	contract, err := assets.NewLBBCertCaller(contractAddress, caller)
+	if err != nil {
+		return false, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	supported, err := contract.SupportsInterface(&bind.CallOpts{Context: ctx}, interfaceID)
+	if err != nil {
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to call supportsInterface: %w", err)
+	}
+
+	return supported, nil
+}
@@ -0,0 +1,366 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+// DefaultRPCBatchSize is the number of eth_call requests sent in one JSON-RPC batch
+const DefaultRPCBatchSize = 100
+
+// RPCBatcher sends several JSON-RPC requests in one round trip, *rpc.Client implements it
+type RPCBatcher interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// ContractCall is one read of a certificate contract function
+type ContractCall struct {
+	Method string
+	Args   []interface{}
+}
+
+// TokenInfo is a minted token of a certificate contract
+type TokenInfo struct {
+	TokenID *big.Int
+	Owner   common.Address
+	URI     string
+}
+
+// EnumerableReader reads ERC721Enumerable state of a certificate contract with batched eth_call requests
+// All reads of one method call are made against the same block
+type EnumerableReader struct {
+	ctx       context.Context
+	rpc       RPCBatcher
+	contract  common.Address
+	abi       *abi.ABI
+	block     *big.Int
+	batchSize int
+}
+
+func NewEnumerableReader(ctx context.Context, batcher RPCBatcher, contractAddress common.Address) (*EnumerableReader, error) {
+	if batcher == nil {
+		return nil, fmt.Errorf("rpc batcher cannot be nil")
+	}
+
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnumerableReader{
+		ctx:       ctx,
+		rpc:       batcher,
+		contract:  contractAddress,
+		abi:       contractABI,
+		batchSize: DefaultRPCBatchSize,
+	}, nil
+}
+
+// Enumerable returns a batched reader for a certificate contract using the EVM RPC of this client
+func (e *EVMClient) Enumerable(contractAddress common.Address) (*EnumerableReader, error) {
+	return NewEnumerableReader(e.GetClient().GetContext(), e.GetClient().GetETHClient().Client(), contractAddress)
+}
+
+// WithBlock returns a new EnumerableReader reading at a fixed block, nil reads at the latest block
+func (r *EnumerableReader) WithBlock(block *big.Int) *EnumerableReader {
+	newReader := *r
+	newReader.block = block
+	return &newReader
+}
+
+// WithBatchSize returns a new EnumerableReader sending at most size requests per JSON-RPC batch
+func (r *EnumerableReader) WithBatchSize(size int) *EnumerableReader {
+	newReader := *r
+	if size > 0 {
+		newReader.batchSize = size
+	}
+	return &newReader
+}
+
+// BalanceOf returns the number of tokens held by owner
+func (r *EnumerableReader) BalanceOf(owner common.Address) (*big.Int, error) {
+	return r.callBigInt(r.block, "balanceOf", owner)
+}
+
+// TotalSupply returns the number of tokens in existence
+func (r *EnumerableReader) TotalSupply() (*big.Int, error) {
+	return r.callBigInt(r.block, "totalSupply")
+}
+
+// TokenByIndex returns the token at index among all tokens of the contract
+func (r *EnumerableReader) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return r.callBigInt(r.block, "tokenByIndex", index)
+}
+
+// TokenOfOwnerByIndex returns the token at index among the tokens held by owner
+func (r *EnumerableReader) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return r.callBigInt(r.block, "tokenOfOwnerByIndex", owner, index)
+}
+
+// TokensOfOwner returns every token held by owner
+// The balance and all indexes are read at one block, in batches instead of one round trip per token
+func (r *EnumerableReader) TokensOfOwner(owner common.Address) ([]*big.Int, error) {
+	block, err := r.pinnedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := r.callBigInt(block, "balanceOf", owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !balance.IsInt64() {
+		return nil, fmt.Errorf("balance of %s is too large to enumerate: %s", owner.Hex(), balance)
+	}
+
+	calls := make([]ContractCall, balance.Int64())
+	for i := range calls {
+		calls[i] = ContractCall{Method: "tokenOfOwnerByIndex", Args: []interface{}{owner, big.NewInt(int64(i))}}
+	}
+
+	return r.batchBigInts(block, calls)
+}
+
+// TokenURIs returns the metadata URI of every token, in the same order
+func (r *EnumerableReader) TokenURIs(tokenIDs []*big.Int) ([]string, error) {
+	calls := make([]ContractCall, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		calls[i] = ContractCall{Method: "tokenURI", Args: []interface{}{tokenID}}
+	}
+
+	results, err := r.Call(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	uris := make([]string, len(results))
+	for i, result := range results {
+		uris[i] = *abi.ConvertType(result[0], new(string)).(*string)
+	}
+
+	return uris, nil
+}
+
+// Call performs contract reads in JSON-RPC batches and returns the unpacked outputs of each, in order
+func (r *EnumerableReader) Call(calls []ContractCall) ([][]interface{}, error) {
+	return r.call(r.block, calls)
+}
+
+// Snapshot returns an iterator over every token of the contract with its owner and URI
+// The whole snapshot is read at one block, pageSize tokens per round of batches
+func (r *EnumerableReader) Snapshot(pageSize int) *TokenSnapshotIterator {
+	if pageSize <= 0 {
+		pageSize = r.batchSize
+	}
+
+	return &TokenSnapshotIterator{
+		reader:   r,
+		pageSize: pageSize,
+		position: -1,
+	}
+}
+
+func (r *EnumerableReader) pinnedBlock() (*big.Int, error) {
+	if r.block != nil {
+		return r.block, nil
+	}
+
+	var head hexutil.Big
+	err := r.rpc.BatchCallContext(r.ctx, []rpc.BatchElem{{Method: "eth_blockNumber", Result: &head}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	return head.ToInt(), nil
+}
+
+func (r *EnumerableReader) callBigInt(block *big.Int, method string, args ...interface{}) (*big.Int, error) {
+	values, err := r.batchBigInts(block, []ContractCall{{Method: method, Args: args}})
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+func (r *EnumerableReader) batchBigInts(block *big.Int, calls []ContractCall) ([]*big.Int, error) {
+	results, err := r.call(block, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*big.Int, len(results))
+	for i, result := range results {
+		values[i] = *abi.ConvertType(result[0], new(*big.Int)).(**big.Int)
+	}
+
+	return values, nil
+}
+
+func (r *EnumerableReader) call(block *big.Int, calls []ContractCall) ([][]interface{}, error) {
+	batch := make([]Call, len(calls))
+	data := make([][]byte, len(calls))
+	for i, call := range calls {
+		batch[i] = Call{Target: r.contract, ABI: r.abi, Method: call.Method, Args: call.Args}
+
+		packed, err := batch[i].pack()
+		if err != nil {
+			return nil, err
+		}
+		data[i] = packed
+	}
+
+	raw, err := batchEthCall(r.ctx, r.rpc, block, batch, data, r.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]interface{}, len(calls))
+	for i, result := range raw {
+		if result.Err != nil {
+			return nil, fmt.Errorf("%s call %d failed: %w", calls[i].Method, i, result.Err)
+		}
+
+		result.unpack(batch[i])
+		if result.Err != nil {
+			return nil, fmt.Errorf("%s call %d: %w", calls[i].Method, i, result.Err)
+		}
+		results[i] = result.Outputs
+	}
+
+	return results, nil
+}
+
+// TokenSnapshotIterator walks every token of a contract, see EnumerableReader.Snapshot
+type TokenSnapshotIterator struct {
+	reader   *EnumerableReader
+	pageSize int
+
+	block    *big.Int
+	total    int64
+	next     int64
+	page     []TokenInfo
+	position int
+	err      error
+}
+
+// Next advances to the next token, it returns false when all tokens were read or an error occurred
+func (it *TokenSnapshotIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.block == nil {
+		if !it.start() {
+			return false
+		}
+	}
+
+	it.position++
+	if it.position < len(it.page) {
+		return true
+	}
+
+	if it.next >= it.total {
+		return false
+	}
+
+	it.err = it.loadPage()
+	if it.err != nil {
+		return false
+	}
+
+	it.position = 0
+	return len(it.page) > 0
+}
+
+// Token returns the token the iterator is positioned at
+func (it *TokenSnapshotIterator) Token() TokenInfo {
+	return it.page[it.position]
+}
+
+// Total returns the total supply at the snapshot block
+func (it *TokenSnapshotIterator) Total() int64 {
+	return it.total
+}
+
+// Block returns the block the snapshot is read at
+func (it *TokenSnapshotIterator) Block() *big.Int {
+	return it.block
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *TokenSnapshotIterator) Err() error {
+	return it.err
+}
+
+func (it *TokenSnapshotIterator) start() bool {
+	block, err := it.reader.pinnedBlock()
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	total, err := it.reader.callBigInt(block, "totalSupply")
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	if !total.IsInt64() {
+		it.err = fmt.Errorf("total supply is too large to enumerate: %s", total)
+		return false
+	}
+
+	it.block = block
+	it.total = total.Int64()
+	return true
+}
+
+func (it *TokenSnapshotIterator) loadPage() error {
+	end := min(it.next+int64(it.pageSize), it.total)
+
+	indexCalls := make([]ContractCall, 0, end-it.next)
+	for i := it.next; i < end; i++ {
+		indexCalls = append(indexCalls, ContractCall{Method: "tokenByIndex", Args: []interface{}{big.NewInt(i)}})
+	}
+
+	tokenIDs, err := it.reader.batchBigInts(it.block, indexCalls)
+	if err != nil {
+		return err
+	}
+
+	// Owner and URI of every token of the page share the same batches
+	detailCalls := make([]ContractCall, 0, 2*len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		detailCalls = append(detailCalls,
+			ContractCall{Method: "ownerOf", Args: []interface{}{tokenID}},
+			ContractCall{Method: "tokenURI", Args: []interface{}{tokenID}},
+		)
+	}
+
+	details, err := it.reader.call(it.block, detailCalls)
+	if err != nil {
+		return err
+	}
+
+	it.page = make([]TokenInfo, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		it.page[i] = TokenInfo{
+			TokenID: tokenID,
+			Owner:   *abi.ConvertType(details[2*i][0], new(common.Address)).(*common.Address),
+			URI:     *abi.ConvertType(details[2*i+1][0], new(string)).(*string),
+		}
+	}
+
+	it.next = end
+	return nil
+}
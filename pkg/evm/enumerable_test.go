@@ -0,0 +1,200 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+// fakeERC721 answers batched eth_call requests from an in-memory token list
+type fakeERC721 struct {
+	t       *testing.T
+	abi     *abi.ABI
+	tokens  []int64
+	owners  map[int64]common.Address
+	batches []int
+	blocks  map[string]bool
+}
+
+func newFakeERC721(t *testing.T) *fakeERC721 {
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	require.NoError(t, err)
+
+	return &fakeERC721{
+		t:      t,
+		abi:    contractABI,
+		owners: make(map[int64]common.Address),
+		blocks: make(map[string]bool),
+	}
+}
+
+func (f *fakeERC721) mint(tokenID int64, owner common.Address) {
+	f.tokens = append(f.tokens, tokenID)
+	f.owners[tokenID] = owner
+}
+
+func (f *fakeERC721) ownerTokens(owner common.Address) []int64 {
+	var tokens []int64
+	for _, tokenID := range f.tokens {
+		if f.owners[tokenID] == owner {
+			tokens = append(tokens, tokenID)
+		}
+	}
+	return tokens
+}
+
+func (f *fakeERC721) BatchCallContext(_ context.Context, batch []rpc.BatchElem) error {
+	f.batches = append(f.batches, len(batch))
+
+	for i := range batch {
+		elem := &batch[i]
+		switch elem.Method {
+		case "eth_blockNumber":
+			*elem.Result.(*hexutil.Big) = hexutil.Big(*big.NewInt(42))
+		case "eth_call":
+			f.blocks[elem.Args[1].(string)] = true
+			out, err := f.call(elem.Args[0].(map[string]interface{})["data"].(hexutil.Bytes))
+			if err != nil {
+				elem.Error = err
+				continue
+			}
+			*elem.Result.(*hexutil.Bytes) = out
+		default:
+			elem.Error = fmt.Errorf("unexpected method %s", elem.Method)
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeERC721) call(data []byte) ([]byte, error) {
+	method, err := f.abi.MethodById(data[:4])
+	require.NoError(f.t, err)
+
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(f.t, err)
+
+	switch method.Name {
+	case "totalSupply":
+		return method.Outputs.Pack(big.NewInt(int64(len(f.tokens))))
+	case "balanceOf":
+		return method.Outputs.Pack(big.NewInt(int64(len(f.ownerTokens(args[0].(common.Address))))))
+	case "tokenByIndex":
+		index := args[0].(*big.Int).Int64()
+		if index >= int64(len(f.tokens)) {
+			return nil, errors.New("execution reverted: ERC721OutOfBoundsIndex")
+		}
+		return method.Outputs.Pack(big.NewInt(f.tokens[index]))
+	case "tokenOfOwnerByIndex":
+		tokens := f.ownerTokens(args[0].(common.Address))
+		return method.Outputs.Pack(big.NewInt(tokens[args[1].(*big.Int).Int64()]))
+	case "ownerOf":
+		owner, ok := f.owners[args[0].(*big.Int).Int64()]
+		if !ok {
+			return nil, errors.New("execution reverted: ERC721NonexistentToken")
+		}
+		return method.Outputs.Pack(owner)
+	case "tokenURI":
+		return method.Outputs.Pack(fmt.Sprintf("https://example.com/%d", args[0].(*big.Int).Int64()))
+	default:
+		f.t.Fatalf("unexpected call to %s", method.Name)
+		return nil, nil
+	}
+}
+
+func TestEnumerableReader(t *testing.T) {
+	holder := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	other := common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+
+	fake := newFakeERC721(t)
+	for i := int64(1); i <= 25; i++ {
+		owner := holder
+		if i%5 == 0 {
+			owner = other
+		}
+		fake.mint(i, owner)
+	}
+
+	reader, err := evm.NewEnumerableReader(context.Background(), fake, testContract)
+	require.NoError(t, err)
+	reader = reader.WithBatchSize(8)
+
+	t.Run("Single reads", func(t *testing.T) {
+		total, err := reader.TotalSupply()
+		require.NoError(t, err)
+		assert.Equal(t, int64(25), total.Int64())
+
+		balance, err := reader.BalanceOf(other)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), balance.Int64())
+
+		tokenID, err := reader.TokenByIndex(big.NewInt(4))
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), tokenID.Int64())
+
+		tokenID, err = reader.TokenOfOwnerByIndex(other, big.NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), tokenID.Int64())
+	})
+
+	t.Run("Tokens of owner are batched at one block", func(t *testing.T) {
+		fake.batches = nil
+		fake.blocks = make(map[string]bool)
+
+		tokens, err := reader.TokensOfOwner(holder)
+		require.NoError(t, err)
+		require.Len(t, tokens, 20)
+		assert.Equal(t, int64(1), tokens[0].Int64())
+		assert.Equal(t, int64(24), tokens[19].Int64())
+
+		// eth_blockNumber, balanceOf, then 20 indexes in batches of 8
+		assert.Equal(t, []int{1, 1, 8, 8, 4}, fake.batches)
+		assert.Equal(t, map[string]bool{"0x2a": true}, fake.blocks)
+	})
+
+	t.Run("Token URIs", func(t *testing.T) {
+		uris, err := reader.TokenURIs([]*big.Int{big.NewInt(3), big.NewInt(7)})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://example.com/3", "https://example.com/7"}, uris)
+	})
+
+	t.Run("Snapshot walks every token", func(t *testing.T) {
+		it := reader.Snapshot(10)
+
+		var tokens []evm.TokenInfo
+		for it.Next() {
+			tokens = append(tokens, it.Token())
+		}
+		require.NoError(t, it.Err())
+		require.Len(t, tokens, 25)
+
+		assert.Equal(t, int64(25), it.Total())
+		assert.Equal(t, int64(42), it.Block().Int64())
+		assert.Equal(t, other, tokens[4].Owner)
+		assert.Equal(t, "https://example.com/25", tokens[24].URI)
+	})
+
+	t.Run("Call errors carry the method", func(t *testing.T) {
+		_, err := reader.Call([]evm.ContractCall{{Method: "ownerOf", Args: []interface{}{big.NewInt(99)}}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ownerOf")
+
+		it := reader.WithBlock(big.NewInt(1)).Snapshot(0)
+		fake.tokens = append(fake.tokens, 99)
+		for it.Next() {
+		}
+		assert.Error(t, it.Err(), "Token without owner should stop the snapshot")
+	})
+}
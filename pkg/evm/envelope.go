@@ -0,0 +1,329 @@
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// PermitEnvelope carries a permit as EIP-712 typed data together with its signature
+// TypedData is the payload of eth_signTypedData_v4 and Signature the 65 byte hex it returns,
+// so a browser or mobile wallet can sign an envelope built here and hand it to a relayer
+type PermitEnvelope struct {
+	TypedData apitypes.TypedData `json:"typedData"`
+	Signature hexutil.Bytes      `json:"signature,omitempty"`
+}
+
+// NewPermitEnvelope returns an unsigned envelope of a Permit
+func NewPermitEnvelope(domain EIP712Domain, permit Permit) (*PermitEnvelope, error) {
+	if _, err := permit.Hash(domain); err != nil {
+		return nil, err
+	}
+
+	return &PermitEnvelope{TypedData: permit.TypedData(domain)}, nil
+}
+
+// NewPermitForAllEnvelope returns an unsigned envelope of a PermitForAll
+func NewPermitForAllEnvelope(domain EIP712Domain, permit PermitForAll) (*PermitEnvelope, error) {
+	if _, err := permit.Hash(domain); err != nil {
+		return nil, err
+	}
+
+	return &PermitEnvelope{TypedData: permit.TypedData(domain)}, nil
+}
+
+// NewTransferPermitEnvelope returns an unsigned envelope of a TransferPermit
+func NewTransferPermitEnvelope(domain EIP712Domain, permit TransferPermit) (*PermitEnvelope, error) {
+	if _, err := permit.Hash(domain); err != nil {
+		return nil, err
+	}
+
+	return &PermitEnvelope{TypedData: permit.TypedData(domain)}, nil
+}
+
+// Sign signs the envelope with signer, which must be the permit owner
+func (e *PermitEnvelope) Sign(signer Signer) error {
+	owner, err := e.Owner()
+	if err != nil {
+		return err
+	}
+	if signer.Address() != owner {
+		return fmt.Errorf("signer %s is not the permit owner %s", signer.Address().Hex(), owner.Hex())
+	}
+
+	hash, err := e.Hash()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer.SignHash(hash)
+	if err != nil {
+		return fmt.Errorf("failed to sign: %w", err)
+	}
+
+	signature, err := permitSignatureFromBytes(sig)
+	if err != nil {
+		return err
+	}
+
+	signature.Normalize()
+	e.Signature = signature.Bytes()
+	return nil
+}
+
+// IsPermitForAll reports whether the envelope carries a PermitForAll rather than a Permit
+func (e *PermitEnvelope) IsPermitForAll() bool {
+	return e.TypedData.PrimaryType == permitForAllPrimaryType
+}
+
+// Domain returns the domain the permit is signed for
+func (e *PermitEnvelope) Domain() (EIP712Domain, error) {
+	domain := e.TypedData.Domain
+	if domain.ChainId == nil {
+		return EIP712Domain{}, fmt.Errorf("envelope domain has no chain ID")
+	}
+
+	if !common.IsHexAddress(domain.VerifyingContract) {
+		return EIP712Domain{}, fmt.Errorf("envelope verifying contract %q is not an address", domain.VerifyingContract)
+	}
+
+	return EIP712Domain{
+		Name:              domain.Name,
+		Version:           domain.Version,
+		ChainID:           (*big.Int)(domain.ChainId),
+		VerifyingContract: common.HexToAddress(domain.VerifyingContract),
+	}, nil
+}
+
+// Permit decodes the Permit of the envelope
+func (e *PermitEnvelope) Permit() (*Permit, error) {
+	if e.TypedData.PrimaryType != permitPrimaryType {
+		return nil, fmt.Errorf("envelope carries %q, not %s", e.TypedData.PrimaryType, permitPrimaryType)
+	}
+
+	message := e.TypedData.Message
+	permit := &Permit{}
+
+	var err error
+	if permit.Owner, err = messageAddress(message, "owner"); err != nil {
+		return nil, err
+	}
+	if permit.Spender, err = messageAddress(message, "spender"); err != nil {
+		return nil, err
+	}
+	if permit.TokenID, err = messageInt(message, "tokenId"); err != nil {
+		return nil, err
+	}
+	if permit.Nonce, err = messageInt(message, "nonce"); err != nil {
+		return nil, err
+	}
+	if permit.Deadline, err = messageInt(message, "deadline"); err != nil {
+		return nil, err
+	}
+
+	return permit, nil
+}
+
+// PermitForAll decodes the PermitForAll of the envelope
+func (e *PermitEnvelope) PermitForAll() (*PermitForAll, error) {
+	if e.TypedData.PrimaryType != permitForAllPrimaryType {
+		return nil, fmt.Errorf("envelope carries %q, not %s", e.TypedData.PrimaryType, permitForAllPrimaryType)
+	}
+
+	message := e.TypedData.Message
+	permit := &PermitForAll{}
+
+	var err error
+	if permit.Owner, err = messageAddress(message, "owner"); err != nil {
+		return nil, err
+	}
+	if permit.Operator, err = messageAddress(message, "operator"); err != nil {
+		return nil, err
+	}
+	if permit.Approved, err = messageBool(message, "approved"); err != nil {
+		return nil, err
+	}
+	if permit.Nonce, err = messageInt(message, "nonce"); err != nil {
+		return nil, err
+	}
+	if permit.Deadline, err = messageInt(message, "deadline"); err != nil {
+		return nil, err
+	}
+
+	return permit, nil
+}
+
+// TransferPermit decodes the TransferPermit of the envelope
+func (e *PermitEnvelope) TransferPermit() (*TransferPermit, error) {
+	if e.TypedData.PrimaryType != transferPermitPrimaryType {
+		return nil, fmt.Errorf("envelope carries %q, not %s", e.TypedData.PrimaryType, transferPermitPrimaryType)
+	}
+
+	message := e.TypedData.Message
+	permit := &TransferPermit{}
+
+	var err error
+	if permit.Owner, err = messageAddress(message, "owner"); err != nil {
+		return nil, err
+	}
+	if permit.To, err = messageAddress(message, "to"); err != nil {
+		return nil, err
+	}
+	if permit.TokenID, err = messageInt(message, "tokenId"); err != nil {
+		return nil, err
+	}
+	if permit.Nonce, err = messageInt(message, "nonce"); err != nil {
+		return nil, err
+	}
+	if permit.Deadline, err = messageInt(message, "deadline"); err != nil {
+		return nil, err
+	}
+
+	return permit, nil
+}
+
+// Owner returns the owner the permit is signed for
+func (e *PermitEnvelope) Owner() (common.Address, error) {
+	return messageAddress(e.TypedData.Message, "owner")
+}
+
+// Deadline returns the deadline of the permit
+func (e *PermitEnvelope) Deadline() (*big.Int, error) {
+	return messageInt(e.TypedData.Message, "deadline")
+}
+
+// Hash returns the digest of the permit
+// The typed data is rebuilt from the decoded message, so an envelope with altered types does not verify
+func (e *PermitEnvelope) Hash() ([]byte, error) {
+	domain, err := e.Domain()
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.TypedData.PrimaryType {
+	case permitForAllPrimaryType:
+		permit, err := e.PermitForAll()
+		if err != nil {
+			return nil, err
+		}
+		return permit.Hash(domain)
+	case transferPermitPrimaryType:
+		permit, err := e.TransferPermit()
+		if err != nil {
+			return nil, err
+		}
+		return permit.Hash(domain)
+	}
+
+	permit, err := e.Permit()
+	if err != nil {
+		return nil, err
+	}
+	return permit.Hash(domain)
+}
+
+// Verify checks that the envelope is signed by the permit owner
+func (e *PermitEnvelope) Verify() error {
+	signature, err := e.PermitSignature()
+	if err != nil {
+		return err
+	}
+	if err := signature.Validate(); err != nil {
+		return err
+	}
+
+	hash, err := e.Hash()
+	if err != nil {
+		return err
+	}
+
+	owner, err := e.Owner()
+	if err != nil {
+		return err
+	}
+
+	valid, err := VerifyPermitSignature(owner, signature, hash)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("envelope is not signed by the permit owner %s", owner.Hex())
+	}
+
+	return nil
+}
+
+// PermitSignature returns the signature in the form ExecutePermitForAll, TransferWithPermit and BurnWithPermit take
+func (e *PermitEnvelope) PermitSignature() (*PermitSignature, error) {
+	if len(e.Signature) == 0 {
+		return nil, fmt.Errorf("envelope is not signed")
+	}
+
+	signature, err := permitSignatureFromBytes(e.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if signature.Deadline, err = e.Deadline(); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
+
+func messageAddress(message apitypes.TypedDataMessage, field string) (common.Address, error) {
+	value, ok := message[field].(string)
+	if !ok || !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("envelope %s is not an address", field)
+	}
+	return common.HexToAddress(value), nil
+}
+
+// messageInt reads a uint256 field, wallets send them as decimal or hex strings or as JSON numbers
+func messageInt(message apitypes.TypedDataMessage, field string) (*big.Int, error) {
+	switch value := message[field].(type) {
+	case string:
+		parsed, ok := math.ParseBig256(value)
+		if !ok || value == "" || parsed.Sign() < 0 {
+			return nil, fmt.Errorf("envelope %s %q is not a uint256", field, value)
+		}
+		return parsed, nil
+	case json.Number:
+		parsed, ok := new(big.Int).SetString(value.String(), 10)
+		if !ok || parsed.Sign() < 0 {
+			return nil, fmt.Errorf("envelope %s %s is not a uint256", field, value)
+		}
+		return parsed, nil
+	case float64:
+		if value < 0 || value != float64(int64(value)) {
+			return nil, fmt.Errorf("envelope %s %v is not a uint256", field, value)
+		}
+		return big.NewInt(int64(value)), nil
+	case *big.Int:
+		return value, nil
+	case *math.HexOrDecimal256:
+		return (*big.Int)(value), nil
+	default:
+		return nil, fmt.Errorf("envelope %s is missing or not a uint256", field)
+	}
+}
+
+func messageBool(message apitypes.TypedDataMessage, field string) (bool, error) {
+	switch value := message[field].(type) {
+	case bool:
+		return value, nil
+	case string:
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("envelope %s is not a bool", field)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"strings"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -17,6 +16,9 @@ import (
 
 type EVMClient struct {
 	account.Account
+	feeStrategy FeeStrategy
+	// certificateFactory deploys certificate contracts with CREATE2. See WithCertificateFactory.
+	certificateFactory common.Address
 }
 
 func NewEVMClient(a account.Account) *EVMClient {
@@ -25,6 +27,29 @@ func NewEVMClient(a account.Account) *EVMClient {
 	}
 }
 
+// WithFeeStrategy returns a new EVMClient pricing its transactions with the given strategy
+func (e *EVMClient) WithFeeStrategy(strategy FeeStrategy) *EVMClient {
+	newEVMClient := *e
+	newEVMClient.feeStrategy = strategy
+	return &newEVMClient
+}
+
+// FeeStrategy returns the strategy pricing the transactions of this client
+func (e *EVMClient) FeeStrategy() FeeStrategy {
+	if e.feeStrategy == nil {
+		return DefaultFeeStrategy
+	}
+	return e.feeStrategy
+}
+
+// Fees returns the gas pricing for the next transaction according to the fee strategy
+func (e *EVMClient) Fees() (Fees, error) {
+	goCtx := e.GetClient().GetContext()
+	ethClient := e.GetClient().GetETHClient()
+
+	return e.FeeStrategy().Fees(goCtx, ethClient)
+}
+
 func (e *EVMClient) GasPrice() (*big.Int, error) {
 	goCtx := e.GetClient().GetContext()
 	ethClient := e.GetClient().GetETHClient()
@@ -123,61 +148,32 @@ func (e *EVMClient) CheckTransactionReceipt(txHash common.Hash) error {
 	return nil
 }
 
-func (e *EVMClient) DynamicABI(contractAddress common.Address, functionName string, args interface{}) (tx *types.Transaction, err error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
-	stringABI, err := assets.GetContractABIString()
+// DynamicABI sends a transaction calling any state-changing function of a certificate contract by name
+// Use DynamicCall for view functions, or Contract for contracts with another ABI
+func (e *EVMClient) DynamicABI(contractAddress common.Address, functionName string, args ...interface{}) (tx *types.Transaction, err error) {
+	handle, err := e.certificateHandle(contractAddress)
 	if err != nil {
-		return &types.Transaction{}, err
+		return nil, err
 	}
 
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
-	if err != nil {
-		return &types.Transaction{}, err
-	}
-
-	// Pack the function call
-	data, err := contractABI.Pack(functionName, args)
-	if err != nil {
-		return nil, fmt.Errorf("failed to pack data: %w", err)
-	}
-
-	gasLimit, err := e.GasLimit(ethereum.CallMsg{
-		From: e.GetEVMAddress(),
-		To:   &contractAddress,
-		Data: data,
-	})
-	if err != nil {
-		return &types.Transaction{}, err
-	}
-
-	nonce, err := e.GetNonce()
-	if err != nil {
-		return &types.Transaction{}, err
-	}
-
-	gasPrice, err := e.GasPrice()
-	if err != nil {
-		return &types.Transaction{}, err
-	}
-
-	tx = types.NewTransaction(nonce, contractAddress, big.NewInt(0), gasLimit, gasPrice, data)
+	return handle.Transact(functionName, args...)
+}
 
-	chainID, err := e.ChainID()
+// DynamicCall calls any view function of a certificate contract by name and returns its decoded outputs
+func (e *EVMClient) DynamicCall(contractAddress common.Address, functionName string, args ...interface{}) ([]interface{}, error) {
+	handle, err := e.certificateHandle(contractAddress)
 	if err != nil {
-		return &types.Transaction{}, err
+		return nil, err
 	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), e.GetPrivateKey())
-	if err != nil {
-		return &types.Transaction{}, err
-	}
+	return handle.Call(functionName, args...)
+}
 
-	err = ethClient.SendTransaction(goCtx, signedTx)
+func (e *EVMClient) certificateHandle(contractAddress common.Address) (*ContractHandle, error) {
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
 	if err != nil {
-		return &types.Transaction{}, err
+		return nil, err
 	}
 
-	return signedTx, nil
+	return e.Contract(contractAddress, *contractABI)
 }
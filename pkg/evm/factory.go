@@ -0,0 +1,137 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/factory"
+)
+
+var (
+	// ErrNoCertificateFactory is returned by deterministic deployments on a client without WithCertificateFactory
+	ErrNoCertificateFactory = errors.New("no certificate factory configured")
+	// ErrCertificateDeployed is returned when the deterministic address of a certificate already has code
+	ErrCertificateDeployed = errors.New("certificate contract already deployed")
+)
+
+// CertificateSalt derives the CREATE2 salt of a schema's certificate contract as keccak256(keccak256(schemaCode) ++ keccak256(salt))
+// salt tells apart several contracts of one schema, "" for the first
+func CertificateSalt(nftSchemaCode, salt string) common.Hash {
+	return crypto.Keccak256Hash(crypto.Keccak256([]byte(nftSchemaCode)), crypto.Keccak256([]byte(salt)))
+}
+
+// PredictCertificateAddress returns the address factoryAddress deploys a certificate contract of deployer to
+// The address is the same on every chain where the factory has the same address, the base URI does not change it
+func PredictCertificateAddress(ctx context.Context, caller bind.ContractCaller, factoryAddress, deployer common.Address, contractName, symbol string, salt common.Hash) (common.Address, error) {
+	contract, err := factory.NewLBBCertFactoryCaller(factoryAddress, caller)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to bind factory: %w", err)
+	}
+
+	address, err := contract.PredictCertificateAddress(&bind.CallOpts{Context: ctx}, deployer, contractName, symbol, salt)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to call predictCertificateAddress: %w", err)
+	}
+
+	return address, nil
+}
+
+// CertificateInitCodeHash returns the hash of the creation code an LBBCertFactory deploys a certificate contract with
+// It is the bundled LBBCert bytecode with the constructor arguments of deployCertificate, so it matches a factory built together with it, see `make contracts`
+func CertificateInitCodeHash(factoryAddress common.Address, contractName, symbol string) (common.Hash, error) {
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	args, err := contractABI.Pack("", contractName, symbol, "", factoryAddress)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to pack constructor: %w", err)
+	}
+
+	return crypto.Keccak256Hash(common.FromHex(assets.LBBCertMetaData.Bin), args), nil
+}
+
+// ComputeCertificateAddress returns the address factoryAddress deploys a certificate contract of deployer to, without calling the factory
+// It derives the address from CertificateInitCodeHash, PredictCertificateAddress asks the factory itself
+func ComputeCertificateAddress(factoryAddress, deployer common.Address, contractName, symbol string, salt common.Hash) (common.Address, error) {
+	initCodeHash, err := CertificateInitCodeHash(factoryAddress, contractName, symbol)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	// The factory salts with keccak256(abi.encode(deployer, salt)) so that deployers cannot take each other's addresses
+	deployerSalt := crypto.Keccak256Hash(common.LeftPadBytes(deployer.Bytes(), 32), salt.Bytes())
+
+	return crypto.CreateAddress2(factoryAddress, deployerSalt, initCodeHash.Bytes()), nil
+}
+
+// WithCertificateFactory returns a new EVMClient deploying certificate contracts through the LBBCertFactory at factoryAddress
+func (e *EVMClient) WithCertificateFactory(factoryAddress common.Address) *EVMClient {
+	newEVMClient := *e
+	newEVMClient.certificateFactory = factoryAddress
+	return &newEVMClient
+}
+
+// CertificateFactory returns the factory of deterministic deployments, the zero address when none is configured
+func (e *EVMClient) CertificateFactory() common.Address {
+	return e.certificateFactory
+}
+
+// DeployCertificateFactory deploys LBBCertFactory from a forge artifact of contracts/src/CertFactory.sol
+// Certificates get the same address on fivenet and sixnet only if the factory does, deploy it from the same account and nonce on both
+func (e *EVMClient) DeployCertificateFactory(artifactPath string) (common.Address, *types.Transaction, error) {
+	return e.deployFromArtifact(artifactPath, factory.LBBCertFactoryMetaData)
+}
+
+// PredictCertificateAddress returns the address DeployCertificateContractDeterministic deploys to, before the contract exists
+// Set it as the origin contract address of the schema, see metadata.MetadataMsg.WithOriginContractAddress
+func (e *EVMClient) PredictCertificateAddress(contractName, symbol, nftSchemaCode, salt string) (common.Address, error) {
+	if e.certificateFactory == (common.Address{}) {
+		return common.Address{}, ErrNoCertificateFactory
+	}
+
+	return PredictCertificateAddress(e.GetClient().GetContext(), e.Backend(), e.certificateFactory, e.GetEVMAddress(), contractName, symbol, CertificateSalt(nftSchemaCode, salt))
+}
+
+// DeployCertificateContractDeterministic deploys LBBCert through the certificate factory with CREATE2
+// The address depends on this account, the name, the symbol, the schema code and salt, see PredictCertificateAddress
+// This account owns the contract once the transaction is mined
+func (e *EVMClient) DeployCertificateContractDeterministic(contractName, symbol, nftSchemaCode, salt string) (common.Address, *types.Transaction, error) {
+	address, err := e.PredictCertificateAddress(contractName, symbol, nftSchemaCode, salt)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	code, err := e.Backend().CodeAt(e.GetClient().GetContext(), address, nil)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to get code of %s: %w", address.Hex(), err)
+	}
+	if len(code) > 0 {
+		return address, nil, fmt.Errorf("%w at %s", ErrCertificateDeployed, address.Hex())
+	}
+
+	contract, err := e.LBBCertFactory(e.certificateFactory)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to bind factory: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	tx, err := contract.DeployCertificate(opts, contractName, symbol, e.certificateBaseURI(nftSchemaCode), CertificateSalt(nftSchemaCode, salt))
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to send deployCertificate: %w", err)
+	}
+
+	return address, tx, nil
+}
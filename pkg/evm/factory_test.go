@@ -0,0 +1,168 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/factory"
+)
+
+// fakeFactory answers predictCertificateAddress with CREATE2 over a stand-in init code of the name and symbol
+type fakeFactory struct {
+	bind.ContractCaller
+	t *testing.T
+}
+
+func (f *fakeFactory) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	contractABI, err := factory.LBBCertFactoryMetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method, err := contractABI.MethodById(call.Data[:4])
+	require.NoError(f.t, err)
+	require.Equal(f.t, "predictCertificateAddress", method.Name)
+
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(f.t, err)
+
+	deployer, name, symbol, salt := args[0].(common.Address), args[1].(string), args[2].(string), args[3].([32]byte)
+	deployerSalt := crypto.Keccak256Hash(common.LeftPadBytes(deployer.Bytes(), 32), salt[:])
+	initCodeHash := crypto.Keccak256([]byte(name), []byte(symbol))
+
+	return method.Outputs.Pack(crypto.CreateAddress2(*call.To, deployerSalt, initCodeHash))
+}
+
+func TestCertificateSalt(t *testing.T) {
+	// keccak256(abi.encodePacked(keccak256("myorg.lbbv01"), keccak256("")))
+	assert.Equal(t,
+		crypto.Keccak256Hash(crypto.Keccak256([]byte("myorg.lbbv01")), crypto.Keccak256(nil)),
+		evm.CertificateSalt("myorg.lbbv01", ""))
+
+	salts := map[common.Hash]string{}
+	for _, key := range [][2]string{
+		{"myorg.lbbv01", ""},
+		{"myorg.lbbv01", "2"},
+		{"myorg.lbbv0", "1"},
+		{"myorg.lbbv02", ""},
+	} {
+		salt := evm.CertificateSalt(key[0], key[1])
+		assert.Equal(t, salt, evm.CertificateSalt(key[0], key[1]), "salt of %v is not stable", key)
+		assert.NotContains(t, salts, salt, "salt of %v collides with %s", key, salts[salt])
+		salts[salt] = key[0] + "/" + key[1]
+	}
+}
+
+func TestPredictCertificateAddress(t *testing.T) {
+	factoryAddress := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+	issuer := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	other := common.HexToAddress("0x0000000000000000000000000000000000000BEE")
+	salt := evm.CertificateSalt("myorg.lbbv01", "")
+
+	predict := func(t *testing.T, deployer common.Address, name string, salt common.Hash) common.Address {
+		address, err := evm.PredictCertificateAddress(context.Background(), &fakeFactory{t: t}, factoryAddress, deployer, name, "CERT", salt)
+		require.NoError(t, err)
+		return address
+	}
+
+	t.Run("Matches CREATE2 of the factory", func(t *testing.T) {
+		deployerSalt := crypto.Keccak256Hash(common.LeftPadBytes(issuer.Bytes(), 32), salt.Bytes())
+		want := crypto.CreateAddress2(factoryAddress, deployerSalt, crypto.Keccak256([]byte("MyCert"), []byte("CERT")))
+
+		assert.Equal(t, want, predict(t, issuer, "MyCert", salt))
+	})
+
+	t.Run("Depends on the deployer, the salt and the name", func(t *testing.T) {
+		address := predict(t, issuer, "MyCert", salt)
+
+		assert.NotEqual(t, address, predict(t, other, "MyCert", salt))
+		assert.NotEqual(t, address, predict(t, issuer, "MyCert", evm.CertificateSalt("myorg.lbbv01", "2")))
+		assert.NotEqual(t, address, predict(t, issuer, "OtherCert", salt))
+	})
+}
+
+// create2Deployer is runtime code that deploys calldata[32:] with CREATE2 and salt calldata[:32] and returns the address
+// It stands in for LBBCertFactory.deployCertificate, which salts the same init code with keccak256(abi.encode(deployer, salt))
+var create2Deployer = common.FromHex("6020360360206000376000356020360360006000f560005260206000f3")
+
+func TestComputeCertificateAddress(t *testing.T) {
+	factoryAddress := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+	issuer := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	salt := evm.CertificateSalt("myorg.lbbv01", "")
+
+	want, err := evm.ComputeCertificateAddress(factoryAddress, issuer, "MyCert", "CERT", salt)
+	require.NoError(t, err)
+
+	t.Run("Matches a CREATE2 deploy of the bundled bytecode", func(t *testing.T) {
+		contractABI, err := assets.LBBCertMetaData.GetAbi()
+		require.NoError(t, err)
+
+		args, err := contractABI.Pack("", "MyCert", "CERT", "", factoryAddress)
+		require.NoError(t, err)
+		initCode := append(common.FromHex(assets.LBBCertMetaData.Bin), args...)
+
+		statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		require.NoError(t, err)
+		statedb.SetCode(factoryAddress, create2Deployer)
+
+		// The bundled bytecode uses PUSH0, so the EVM runs with Shanghai
+		blockContext := vm.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			GetHash:     func(uint64) common.Hash { return common.Hash{} },
+			BlockNumber: big.NewInt(1),
+			Difficulty:  new(big.Int),
+			GasLimit:    30_000_000,
+			BaseFee:     new(big.Int),
+			Random:      &common.Hash{},
+		}
+		evmInstance := vm.NewEVMWithHooks(vm.NewDefaultOpCodeHooks(), blockContext, vm.TxContext{Origin: issuer, GasPrice: new(big.Int)}, statedb, params.AllDevChainProtocolChanges, vm.Config{})
+
+		deployerSalt := crypto.Keccak256(common.LeftPadBytes(issuer.Bytes(), 32), salt.Bytes())
+		ret, _, err := evmInstance.Call(vm.AccountRef(issuer), factoryAddress, append(deployerSalt, initCode...), blockContext.GasLimit, new(big.Int))
+		require.NoError(t, err)
+		require.Equal(t, want, common.BytesToAddress(ret))
+
+		// The certificate is live at the address and owned by the factory until deployCertificate hands it over
+		data, err := contractABI.Pack("owner")
+		require.NoError(t, err)
+		ret, _, err = evmInstance.Call(vm.AccountRef(issuer), want, data, blockContext.GasLimit, new(big.Int))
+		require.NoError(t, err)
+		assert.Equal(t, factoryAddress, common.BytesToAddress(ret))
+	})
+
+	t.Run("Depends on the deployer, the salt, the name and the factory", func(t *testing.T) {
+		for _, other := range []func() (common.Address, error){
+			func() (common.Address, error) {
+				return evm.ComputeCertificateAddress(factoryAddress, common.HexToAddress("0x0BEE"), "MyCert", "CERT", salt)
+			},
+			func() (common.Address, error) {
+				return evm.ComputeCertificateAddress(factoryAddress, issuer, "MyCert", "CERT", evm.CertificateSalt("myorg.lbbv01", "2"))
+			},
+			func() (common.Address, error) {
+				return evm.ComputeCertificateAddress(factoryAddress, issuer, "OtherCert", "CERT", salt)
+			},
+			func() (common.Address, error) {
+				return evm.ComputeCertificateAddress(issuer, issuer, "MyCert", "CERT", salt)
+			},
+		} {
+			address, err := other()
+			require.NoError(t, err)
+			assert.NotEqual(t, want, address)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeBackend is the part of the EVM RPC a FeeStrategy may query
+type FeeBackend interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Fees is the gas pricing of a transaction
+// Either GasPrice is set for a legacy transaction, or GasTipCap and GasFeeCap for an EIP-1559 one
+type Fees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// IsDynamic reports whether the fees describe an EIP-1559 dynamic fee transaction
+func (f Fees) IsDynamic() bool {
+	return f.GasPrice == nil
+}
+
+// Validate checks that exactly one pricing mode is set
+func (f Fees) Validate() error {
+	if f.GasPrice != nil {
+		if f.GasTipCap != nil || f.GasFeeCap != nil {
+			return errors.New("gas price cannot be combined with tip or fee cap")
+		}
+		return nil
+	}
+
+	if f.GasTipCap == nil || f.GasFeeCap == nil {
+		return errors.New("dynamic fees need both a tip cap and a fee cap")
+	}
+
+	if f.GasFeeCap.Cmp(f.GasTipCap) < 0 {
+		return fmt.Errorf("fee cap %s is lower than tip cap %s", f.GasFeeCap, f.GasTipCap)
+	}
+
+	return nil
+}
+
+// Apply sets the fees on transaction options used by the bindings
+func (f Fees) Apply(opts *bind.TransactOpts) {
+	opts.GasPrice = f.GasPrice
+	opts.GasTipCap = f.GasTipCap
+	opts.GasFeeCap = f.GasFeeCap
+}
+
+// TxData builds an unsigned transaction priced with the fees
+func (f Fees) TxData(chainID *big.Int, nonce uint64, to *common.Address, value *big.Int, gas uint64, data []byte) types.TxData {
+	if !f.IsDynamic() {
+		return &types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: f.GasPrice,
+			Gas:      gas,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}
+	}
+
+	return &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: f.GasTipCap,
+		GasFeeCap: f.GasFeeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	}
+}
+
+// FeeStrategy decides how EVM transactions sent by an EVMClient are priced
+type FeeStrategy interface {
+	Fees(ctx context.Context, backend FeeBackend) (Fees, error)
+}
+
+var (
+	_ FeeStrategy = LegacyFeeStrategy{}
+	_ FeeStrategy = DynamicFeeStrategy{}
+	_ FeeStrategy = FixedFeeStrategy{}
+)
+
+// LegacyFeeStrategy prices transactions with the gas price suggested by the node
+type LegacyFeeStrategy struct{}
+
+func (LegacyFeeStrategy) Fees(ctx context.Context, backend FeeBackend) (Fees, error) {
+	gasPrice, err := backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return Fees{}, fmt.Errorf("failed to suggest gas price: %w", err)
+	}
+
+	return Fees{GasPrice: gasPrice}, nil
+}
+
+// DynamicFeeStrategy prices transactions with EIP-1559 fees
+// The tip is suggested by the node and the fee cap is BaseFeeMultiplier times the latest base fee plus the tip.
+// Chains without a base fee in the latest header fall back to the legacy gas price
+type DynamicFeeStrategy struct {
+	// BaseFeeMultiplier is how many times the base fee may grow before the transaction is priced out, 2 when zero
+	BaseFeeMultiplier int64
+}
+
+func (s DynamicFeeStrategy) Fees(ctx context.Context, backend FeeBackend) (Fees, error) {
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Fees{}, fmt.Errorf("failed to get latest header: %w", err)
+	}
+
+	if header.BaseFee == nil {
+		return LegacyFeeStrategy{}.Fees(ctx, backend)
+	}
+
+	gasTipCap, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return Fees{}, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+	}
+
+	multiplier := s.BaseFeeMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	gasFeeCap := new(big.Int).Mul(header.BaseFee, big.NewInt(multiplier))
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+
+	return Fees{GasTipCap: gasTipCap, GasFeeCap: gasFeeCap}, nil
+}
+
+// FixedFeeStrategy prices every transaction with the same fees without querying the node
+type FixedFeeStrategy struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// NewFixedGasPrice returns a strategy sending legacy transactions at a fixed gas price
+func NewFixedGasPrice(gasPrice *big.Int) FixedFeeStrategy {
+	return FixedFeeStrategy{GasPrice: gasPrice}
+}
+
+// NewFixedDynamicFee returns a strategy sending EIP-1559 transactions with a fixed tip and fee cap
+func NewFixedDynamicFee(gasTipCap, gasFeeCap *big.Int) FixedFeeStrategy {
+	return FixedFeeStrategy{GasTipCap: gasTipCap, GasFeeCap: gasFeeCap}
+}
+
+func (s FixedFeeStrategy) Fees(_ context.Context, _ FeeBackend) (Fees, error) {
+	fees := Fees{
+		GasPrice:  copyBig(s.GasPrice),
+		GasTipCap: copyBig(s.GasTipCap),
+		GasFeeCap: copyBig(s.GasFeeCap),
+	}
+	if err := fees.Validate(); err != nil {
+		return Fees{}, err
+	}
+
+	return fees, nil
+}
+
+// DefaultFeeStrategy is used by an EVMClient without an explicit strategy
+var DefaultFeeStrategy FeeStrategy = DynamicFeeStrategy{}
+
+func copyBig(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+	return new(big.Int).Set(x)
+}
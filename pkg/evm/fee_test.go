@@ -0,0 +1,133 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+type fakeFeeBackend struct {
+	gasPrice *big.Int
+	tipCap   *big.Int
+	baseFee  *big.Int
+	err      error
+}
+
+func (f fakeFeeBackend) SuggestGasPrice(context.Context) (*big.Int, error) {
+	return f.gasPrice, f.err
+}
+
+func (f fakeFeeBackend) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return f.tipCap, f.err
+}
+
+func (f fakeFeeBackend) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	return &types.Header{BaseFee: f.baseFee}, f.err
+}
+
+func TestFeeStrategies(t *testing.T) {
+	ctx := context.Background()
+	backend := fakeFeeBackend{
+		gasPrice: big.NewInt(1_000),
+		tipCap:   big.NewInt(10),
+		baseFee:  big.NewInt(500),
+	}
+
+	t.Run("Legacy", func(t *testing.T) {
+		fees, err := evm.LegacyFeeStrategy{}.Fees(ctx, backend)
+		require.NoError(t, err)
+		assert.False(t, fees.IsDynamic())
+		assert.Equal(t, big.NewInt(1_000), fees.GasPrice)
+	})
+
+	t.Run("Dynamic", func(t *testing.T) {
+		fees, err := evm.DynamicFeeStrategy{}.Fees(ctx, backend)
+		require.NoError(t, err)
+		assert.True(t, fees.IsDynamic())
+		assert.Equal(t, big.NewInt(10), fees.GasTipCap)
+		assert.Equal(t, big.NewInt(2*500+10), fees.GasFeeCap)
+
+		fees, err = evm.DynamicFeeStrategy{BaseFeeMultiplier: 3}.Fees(ctx, backend)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(3*500+10), fees.GasFeeCap)
+	})
+
+	t.Run("Dynamic falls back to legacy before London", func(t *testing.T) {
+		preLondon := backend
+		preLondon.baseFee = nil
+
+		fees, err := evm.DynamicFeeStrategy{}.Fees(ctx, preLondon)
+		require.NoError(t, err)
+		assert.False(t, fees.IsDynamic())
+		assert.Equal(t, big.NewInt(1_000), fees.GasPrice)
+	})
+
+	t.Run("Fixed", func(t *testing.T) {
+		fees, err := evm.NewFixedGasPrice(big.NewInt(7)).Fees(ctx, fakeFeeBackend{err: errors.New("unused")})
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(7), fees.GasPrice)
+
+		fees, err = evm.NewFixedDynamicFee(big.NewInt(1), big.NewInt(9)).Fees(ctx, nil)
+		require.NoError(t, err)
+		assert.True(t, fees.IsDynamic())
+
+		_, err = evm.NewFixedDynamicFee(big.NewInt(9), big.NewInt(1)).Fees(ctx, nil)
+		assert.Error(t, err, "Fee cap below tip must be rejected")
+
+		_, err = evm.FixedFeeStrategy{}.Fees(ctx, nil)
+		assert.Error(t, err, "Empty fees must be rejected")
+	})
+
+	t.Run("Backend errors are returned", func(t *testing.T) {
+		_, err := evm.DynamicFeeStrategy{}.Fees(ctx, fakeFeeBackend{err: errors.New("rpc down")})
+		assert.Error(t, err)
+	})
+}
+
+func TestFeesSigning(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	chainID := big.NewInt(150)
+	to := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	signer := types.LatestSignerForChainID(chainID)
+
+	testCases := []struct {
+		name   string
+		fees   evm.Fees
+		txType uint8
+	}{
+		{"Legacy", evm.Fees{GasPrice: big.NewInt(5)}, types.LegacyTxType},
+		{"Dynamic", evm.Fees{GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(5)}, types.DynamicFeeTxType},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := types.SignNewTx(key, signer, tc.fees.TxData(chainID, 3, &to, big.NewInt(1), 21_000, nil))
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.txType, tx.Type())
+			assert.Equal(t, chainID, tx.ChainId())
+			assert.Equal(t, uint64(3), tx.Nonce())
+
+			sender, err := types.Sender(signer, tx)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+
+			opts := &bind.TransactOpts{}
+			tc.fees.Apply(opts)
+			assert.Equal(t, tc.fees.GasPrice, opts.GasPrice)
+			assert.Equal(t, tc.fees.GasFeeCap, opts.GasFeeCap)
+		})
+	}
+}
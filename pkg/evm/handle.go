@@ -0,0 +1,413 @@
+package evm
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ContractArtifact is a compiled contract, parsed from a bare ABI array or a foundry or hardhat artifact
+type ContractArtifact struct {
+	ABI abi.ABI
+	// Bytecode is the creation code, empty when the input only had an ABI
+	Bytecode []byte
+	// StorageLayout is set for foundry artifacts built with extra_output = ["storageLayout"]
+	StorageLayout *StorageLayout
+}
+
+// ParseArtifact reads a contract ABI from JSON
+// It accepts a bare ABI array, a foundry out/*.json artifact and a hardhat artifact
+func ParseArtifact(data []byte) (*ContractArtifact, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("contract artifact is empty")
+	}
+
+	if data[0] == '[' {
+		contractABI, err := abi.JSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ABI: %w", err)
+		}
+		return &ContractArtifact{ABI: contractABI}, nil
+	}
+
+	var artifact struct {
+		ABI           json.RawMessage `json:"abi"`
+		Bytecode      json.RawMessage `json:"bytecode"`
+		StorageLayout *StorageLayout  `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, fmt.Errorf("failed to parse contract artifact: %w", err)
+	}
+	if len(artifact.ABI) == 0 {
+		return nil, fmt.Errorf("contract artifact has no abi field")
+	}
+
+	contractABI, err := abi.JSON(bytes.NewReader(artifact.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	bytecode, err := parseArtifactBytecode(artifact.Bytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractArtifact{ABI: contractABI, Bytecode: bytecode, StorageLayout: artifact.StorageLayout}, nil
+}
+
+// LoadArtifact reads a contract ABI from a JSON file, see ParseArtifact
+func LoadArtifact(path string) (*ContractArtifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read contract artifact: %w", err)
+	}
+
+	artifact, err := ParseArtifact(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return artifact, nil
+}
+
+// parseArtifactBytecode accepts the hardhat form "0x..." and the foundry form {"object": "0x..."}
+func parseArtifactBytecode(raw json.RawMessage) ([]byte, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var code string
+	if err := json.Unmarshal(raw, &code); err != nil {
+		var object struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil, fmt.Errorf("failed to parse bytecode: %w", err)
+		}
+		code = object.Object
+	}
+
+	if code == "" || code == "0x" {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(code, "0x") {
+		code = "0x" + code
+	}
+
+	bytecode, err := hexutil.Decode(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bytecode, unlinked libraries are not supported: %w", err)
+	}
+
+	return bytecode, nil
+}
+
+// RevertError is a decoded contract revert
+type RevertError struct {
+	// Name is "Error" for require and revert strings, "Panic" for assertion failures, or the custom error name
+	Name string
+	// Reason is the revert string of Error and Panic reverts
+	Reason string
+	// Args are the arguments of a custom error
+	Args []interface{}
+	Data []byte
+}
+
+func (e *RevertError) Error() string {
+	switch {
+	case e.Reason != "":
+		return fmt.Sprintf("execution reverted: %s", e.Reason)
+	case e.Name != "":
+		return fmt.Sprintf("execution reverted: %s%v", e.Name, e.Args)
+	case len(e.Data) > 0:
+		return fmt.Sprintf("execution reverted: %s", hexutil.Encode(e.Data))
+	default:
+		return "execution reverted"
+	}
+}
+
+// DecodedEvent is a contract event decoded from a receipt log
+type DecodedEvent struct {
+	Name string
+	// Fields holds indexed and non-indexed arguments by name
+	Fields map[string]interface{}
+	Log    types.Log
+}
+
+// ContractHandle reads, writes and decodes a contract described by any ABI
+type ContractHandle struct {
+	address      common.Address
+	abi          abi.ABI
+	contract     *bind.BoundContract
+	callOpts     *bind.CallOpts
+	transactOpts func() (*bind.TransactOpts, error)
+	wait         func(txHash common.Hash) (*types.Receipt, error)
+}
+
+func NewContractHandle(address common.Address, contractABI abi.ABI, backend bind.ContractBackend) (*ContractHandle, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("contract backend cannot be nil")
+	}
+
+	return &ContractHandle{
+		address:  address,
+		abi:      contractABI,
+		contract: bind.NewBoundContract(address, contractABI, backend, backend, backend),
+	}, nil
+}
+
+// Contract returns a handle on the contract at address using the account and fee strategy of this client
+func (e *EVMClient) Contract(address common.Address, contractABI abi.ABI) (*ContractHandle, error) {
+	handle, err := NewContractHandle(address, contractABI, e.Backend())
+	if err != nil {
+		return nil, err
+	}
+
+	handle.callOpts = e.CallOpts()
+	handle.transactOpts = e.TransactOpts
+	handle.wait = e.GetClient().WaitForEVMTransaction
+
+	return handle, nil
+}
+
+// ContractFromArtifact returns a handle on the contract at address described by an ABI or artifact file
+func (e *EVMClient) ContractFromArtifact(address common.Address, path string) (*ContractHandle, error) {
+	artifact, err := LoadArtifact(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Contract(address, artifact.ABI)
+}
+
+// WithCallOpts returns a new ContractHandle making calls with the given options
+func (h *ContractHandle) WithCallOpts(opts *bind.CallOpts) *ContractHandle {
+	newHandle := *h
+	newHandle.callOpts = opts
+	return &newHandle
+}
+
+// WithTransactOpts returns a new ContractHandle signing transactions with options from the given function
+// The function is called once per transaction so that nonce and fees are fresh
+func (h *ContractHandle) WithTransactOpts(opts func() (*bind.TransactOpts, error)) *ContractHandle {
+	newHandle := *h
+	newHandle.transactOpts = opts
+	return &newHandle
+}
+
+// WithWait returns a new ContractHandle waiting for receipts with the given function
+func (h *ContractHandle) WithWait(wait func(txHash common.Hash) (*types.Receipt, error)) *ContractHandle {
+	newHandle := *h
+	newHandle.wait = wait
+	return &newHandle
+}
+
+func (h *ContractHandle) Address() common.Address {
+	return h.address
+}
+
+func (h *ContractHandle) ABI() abi.ABI {
+	return h.abi
+}
+
+// Call runs a view or pure function and returns its decoded outputs
+func (h *ContractHandle) Call(method string, args ...interface{}) ([]interface{}, error) {
+	if _, ok := h.abi.Methods[method]; !ok {
+		return nil, fmt.Errorf("method %s not found in ABI", method)
+	}
+
+	var outputs []interface{}
+	if err := h.contract.Call(h.callOpts, &outputs, method, args...); err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, decodeRPCError(&h.abi, err))
+	}
+
+	return outputs, nil
+}
+
+// Transact sends a transaction calling method
+// Nonce, gas limit and fees are filled in from the chain unless the transact options set them
+func (h *ContractHandle) Transact(method string, args ...interface{}) (*types.Transaction, error) {
+	abiMethod, ok := h.abi.Methods[method]
+	if !ok {
+		return nil, fmt.Errorf("method %s not found in ABI", method)
+	}
+	if abiMethod.IsConstant() {
+		return nil, fmt.Errorf("method %s is %s, use Call instead", method, abiMethod.StateMutability)
+	}
+
+	if h.transactOpts == nil {
+		return nil, fmt.Errorf("contract handle has no transact options")
+	}
+
+	opts, err := h.transactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := h.contract.Transact(opts, method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", method, decodeRPCError(&h.abi, err))
+	}
+
+	return tx, nil
+}
+
+// TransactAndWait sends a transaction calling method and waits for its receipt
+// A reverted transaction returns its receipt together with an error
+func (h *ContractHandle) TransactAndWait(method string, args ...interface{}) (*types.Receipt, error) {
+	if h.wait == nil {
+		return nil, fmt.Errorf("contract handle cannot wait for receipts")
+	}
+
+	tx, err := h.Transact(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := h.wait(tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		return receipt, fmt.Errorf("%s transaction %s reverted", method, tx.Hash().Hex())
+	}
+
+	return receipt, nil
+}
+
+// DecodeEvents decodes the logs of receipt emitted by this contract
+// Logs of other contracts and events missing from the ABI are skipped
+func (h *ContractHandle) DecodeEvents(receipt *types.Receipt) ([]DecodedEvent, error) {
+	var events []DecodedEvent
+	for _, log := range receipt.Logs {
+		if log.Address != h.address || len(log.Topics) == 0 {
+			continue
+		}
+
+		event, err := h.DecodeEvent(*log)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			events = append(events, *event)
+		}
+	}
+
+	return events, nil
+}
+
+// DecodeEvent decodes one log, it returns nil when the ABI has no event matching the log
+func (h *ContractHandle) DecodeEvent(log types.Log) (*DecodedEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+
+	event, err := h.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	fields := make(map[string]interface{})
+	if len(log.Data) > 0 {
+		if err := h.abi.UnpackIntoMap(fields, event.Name, log.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode %s data: %w", event.Name, err)
+		}
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to decode %s topics: %w", event.Name, err)
+	}
+
+	return &DecodedEvent{
+		Name:   event.Name,
+		Fields: fields,
+		Log:    log,
+	}, nil
+}
+
+// DecodeRevert decodes revert data into a RevertError
+// Error(string), Panic(uint256) and the custom errors of the ABI are recognised
+func (h *ContractHandle) DecodeRevert(data []byte) *RevertError {
+	return decodeRevert(&h.abi, data)
+}
+
+func decodeRevert(contractABI *abi.ABI, data []byte) *RevertError {
+	revert := &RevertError{Data: data}
+	if len(data) < 4 {
+		return revert
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		revert.Reason = reason
+		revert.Name = "Error"
+		if bytes.Equal(data[:4], panicSelector) {
+			revert.Name = "Panic"
+		}
+		return revert
+	}
+
+	if contractABI == nil {
+		return revert
+	}
+
+	customError, err := contractABI.ErrorByID([4]byte(data[:4]))
+	if err != nil {
+		return revert
+	}
+
+	args, err := customError.Unpack(data)
+	if err != nil {
+		return revert
+	}
+
+	revert.Name = customError.Name
+	revert.Args, _ = args.([]interface{})
+	return revert
+}
+
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+// decodeRPCError replaces an RPC error carrying revert data by the decoded revert
+func decodeRPCError(contractABI *abi.ABI, err error) error {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err
+	}
+
+	var data []byte
+	switch value := dataErr.ErrorData().(type) {
+	case string:
+		decoded, decodeErr := hexutil.Decode(value)
+		if decodeErr != nil {
+			return err
+		}
+		data = decoded
+	case []byte:
+		data = value
+	default:
+		return err
+	}
+
+	return decodeRevert(contractABI, data)
+}
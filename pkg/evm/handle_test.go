@@ -0,0 +1,204 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// revertRPCError mimics the error of an eth_call that reverted, carrying the revert data
+type revertRPCError struct {
+	data []byte
+}
+
+func (e revertRPCError) Error() string          { return "execution reverted" }
+func (e revertRPCError) ErrorData() interface{} { return hexutil.Encode(e.data) }
+
+// fakeContractBackend answers calls of a certificate contract and records sent transactions
+type fakeContractBackend struct {
+	bind.ContractBackend
+	token *fakeERC721
+	sent  []*types.Transaction
+}
+
+func (f *fakeContractBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x60, 0x80}, nil
+}
+
+func (f *fakeContractBackend) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := f.token.abi.MethodById(call.Data[:4])
+	require.NoError(f.token.t, err)
+
+	// Nonexistent tokens revert with the custom error of OpenZeppelin 5
+	if method.Name == "ownerOf" {
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		require.NoError(f.token.t, err)
+
+		tokenID := args[0].(*big.Int)
+		if _, ok := f.token.owners[tokenID.Int64()]; !ok {
+			customError := f.token.abi.Errors["ERC721NonexistentToken"]
+			data, err := customError.Inputs.Pack(tokenID)
+			require.NoError(f.token.t, err)
+			return nil, revertRPCError{data: append(customError.ID[:4:4], data...)}
+		}
+	}
+
+	return f.token.call(call.Data)
+}
+
+func (f *fakeContractBackend) PendingCodeAt(context.Context, common.Address) ([]byte, error) {
+	return []byte{0x60, 0x80}, nil
+}
+
+func (f *fakeContractBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return uint64(len(f.sent)), nil
+}
+
+func (f *fakeContractBackend) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 100_000, nil
+}
+
+func (f *fakeContractBackend) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	f.sent = append(f.sent, tx)
+	return nil
+}
+
+func TestParseArtifact(t *testing.T) {
+	t.Run("Foundry artifact", func(t *testing.T) {
+		artifact, err := evm.LoadArtifact("assets/contract.json")
+		require.NoError(t, err)
+		assert.Contains(t, artifact.ABI.Methods, "safeMint")
+		assert.NotEmpty(t, artifact.Bytecode)
+	})
+
+	t.Run("Bare ABI and hardhat artifact", func(t *testing.T) {
+		bare := `[{"type":"function","name":"ping","inputs":[],"outputs":[],"stateMutability":"nonpayable"}]`
+
+		artifact, err := evm.ParseArtifact([]byte(bare))
+		require.NoError(t, err)
+		assert.Contains(t, artifact.ABI.Methods, "ping")
+		assert.Empty(t, artifact.Bytecode)
+
+		artifact, err = evm.ParseArtifact([]byte(`{"abi":` + bare + `,"bytecode":"0x6080"}`))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x60, 0x80}, artifact.Bytecode)
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		_, err := evm.ParseArtifact([]byte(`{"bytecode":"0x"}`))
+		assert.ErrorContains(t, err, "no abi")
+
+		_, err = evm.ParseArtifact(nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestContractHandle(t *testing.T) {
+	holder := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+
+	token := newFakeERC721(t)
+	token.mint(1, holder)
+
+	backend := &fakeContractBackend{token: token}
+	handle, err := evm.NewContractHandle(testContract, *token.abi, backend)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	handle = handle.WithTransactOpts(func() (*bind.TransactOpts, error) {
+		opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(666))
+		if err != nil {
+			return nil, err
+		}
+		opts.GasPrice = big.NewInt(1_000_000_000_000)
+		return opts, nil
+	})
+
+	t.Run("Call decodes outputs", func(t *testing.T) {
+		outputs, err := handle.Call("ownerOf", big.NewInt(1))
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{holder}, outputs)
+	})
+
+	t.Run("Call decodes custom errors", func(t *testing.T) {
+		_, err := handle.Call("ownerOf", big.NewInt(7))
+		require.Error(t, err)
+
+		var revert *evm.RevertError
+		require.ErrorAs(t, err, &revert)
+		assert.Equal(t, "ERC721NonexistentToken", revert.Name)
+		assert.Equal(t, []interface{}{big.NewInt(7)}, revert.Args)
+	})
+
+	t.Run("Transact fills nonce and gas", func(t *testing.T) {
+		tx, err := handle.Transact("transferFrom", holder, common.HexToAddress("0x01"), big.NewInt(1))
+		require.NoError(t, err)
+		require.Len(t, backend.sent, 1)
+		assert.Equal(t, uint64(0), tx.Nonce())
+		assert.Equal(t, uint64(100_000), tx.Gas())
+		assert.Equal(t, testContract, *tx.To())
+
+		_, err = handle.Transact("ownerOf", big.NewInt(1))
+		assert.ErrorContains(t, err, "use Call")
+
+		_, err = handle.Transact("missing")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("Decode events", func(t *testing.T) {
+		transfer := token.abi.Events["Transfer"]
+		receipt := &types.Receipt{Logs: []*types.Log{
+			{
+				Address: testContract,
+				Topics: []common.Hash{
+					transfer.ID,
+					common.BytesToHash(holder.Bytes()),
+					common.BytesToHash(common.HexToAddress("0x01").Bytes()),
+					common.BigToHash(big.NewInt(1)),
+				},
+			},
+			// Logs of other contracts are skipped
+			{Address: common.HexToAddress("0x02"), Topics: []common.Hash{transfer.ID}},
+		}}
+
+		events, err := handle.DecodeEvents(receipt)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "Transfer", events[0].Name)
+		assert.Equal(t, holder, events[0].Fields["from"])
+		assert.Equal(t, big.NewInt(1), events[0].Fields["tokenId"])
+	})
+
+	t.Run("Decode revert strings and panics", func(t *testing.T) {
+		revert := handle.DecodeRevert(revertData(t, errors.New("execution reverted: not allowed")))
+		assert.Equal(t, "Error", revert.Name)
+		assert.Equal(t, "not allowed", revert.Reason)
+		assert.EqualError(t, revert, "execution reverted: not allowed")
+
+		uint256, err := abi.NewType("uint256", "", nil)
+		require.NoError(t, err)
+		code, err := abi.Arguments{{Type: uint256}}.Pack(big.NewInt(0x11))
+		require.NoError(t, err)
+
+		revert = handle.DecodeRevert(append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], code...))
+		assert.Equal(t, "Panic", revert.Name)
+		assert.Contains(t, revert.Reason, "overflow")
+
+		revert = handle.DecodeRevert([]byte{0xde, 0xad, 0xbe, 0xef})
+		assert.Empty(t, revert.Name)
+		assert.EqualError(t, revert, "execution reverted: 0xdeadbeef")
+	})
+}
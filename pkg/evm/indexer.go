@@ -0,0 +1,425 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+const (
+	DefaultIndexerBatchSize    = uint64(2_000)
+	DefaultIndexerSafetyWindow = uint64(12)
+	DefaultIndexerPollInterval = 6 * time.Second
+)
+
+// ContractEventKind is the name of a certificate contract event in the ABI
+type ContractEventKind string
+
+const (
+	EventTransfer         ContractEventKind = "Transfer"
+	EventSafeMint         ContractEventKind = "safeMintEvent"
+	EventPermitUsed       ContractEventKind = "PermitUsed"
+	EventPermitForAllUsed ContractEventKind = "PermitForAllUsed"
+)
+
+// IndexedEventKinds are the events an Indexer decodes
+var IndexedEventKinds = []ContractEventKind{EventTransfer, EventSafeMint, EventPermitUsed, EventPermitForAllUsed}
+
+// ContractEvent is a decoded certificate contract event
+// Exactly one of Transfer, SafeMint, PermitUsed and PermitForAllUsed is set, matching Kind
+type ContractEvent struct {
+	Kind        ContractEventKind
+	Contract    common.Address
+	BlockNumber uint64
+	BlockHash   common.Hash
+	BlockTime   uint64
+	TxHash      common.Hash
+	LogIndex    uint
+	// Removed marks an event delivered earlier whose block was dropped by a reorg
+	Removed bool
+
+	Transfer         *assets.LBBCertTransfer
+	SafeMint         *assets.LBBCertSafeMintEvent
+	PermitUsed       *assets.LBBCertPermitUsed
+	PermitForAllUsed *assets.LBBCertPermitForAllUsed
+}
+
+// IsMint reports whether the event is a Transfer from the zero address
+func (ev ContractEvent) IsMint() bool {
+	return ev.Transfer != nil && ev.Transfer.From == (common.Address{})
+}
+
+// IsBurn reports whether the event is a Transfer to the zero address
+func (ev ContractEvent) IsBurn() bool {
+	return ev.Transfer != nil && ev.Transfer.To == (common.Address{})
+}
+
+// IndexerBackend is the part of the EVM RPC the Indexer reads from
+type IndexerBackend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// IndexerConfig selects the contracts and block ranges an Indexer scans
+type IndexerConfig struct {
+	Contracts []common.Address
+	// FromBlock is the first block scanned when no checkpoint exists, usually the deployment block
+	FromBlock uint64
+	// BatchSize is the number of blocks per FilterLogs request
+	BatchSize uint64
+	// SafetyWindow is the number of blocks behind the checkpoint re-scanned on every pass to detect reorgs
+	SafetyWindow uint64
+	// PollInterval is the delay between passes in Run
+	PollInterval time.Duration
+	// CheckpointKey names the checkpoint in the store, derived from the contracts when empty
+	CheckpointKey string
+}
+
+// Indexer scans certificate contracts for Transfer, safeMintEvent, PermitUsed and PermitForAllUsed events
+// Delivery is at least once: after a restart the safety window behind the checkpoint is delivered again,
+// so consumers should key events by TxHash and LogIndex
+type Indexer struct {
+	backend IndexerBackend
+	store   CheckpointStore
+	config  IndexerConfig
+
+	filterer *assets.LBBCertFilterer
+	topics   []common.Hash
+
+	mu sync.Mutex
+	// recent holds the blocks with events inside the safety window, used to detect reorgs
+	recent map[uint64]indexedBlock
+}
+
+type indexedBlock struct {
+	hash   common.Hash
+	events []ContractEvent
+}
+
+func NewIndexer(backend IndexerBackend, store CheckpointStore, config IndexerConfig) (*Indexer, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("indexer backend cannot be nil")
+	}
+
+	if store == nil {
+		return nil, fmt.Errorf("checkpoint store cannot be nil")
+	}
+
+	if len(config.Contracts) == 0 {
+		return nil, fmt.Errorf("at least one contract is required")
+	}
+
+	if config.BatchSize == 0 {
+		config.BatchSize = DefaultIndexerBatchSize
+	}
+
+	if config.SafetyWindow == 0 {
+		config.SafetyWindow = DefaultIndexerSafetyWindow
+	}
+
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultIndexerPollInterval
+	}
+
+	if config.CheckpointKey == "" {
+		addresses := make([]string, 0, len(config.Contracts))
+		for _, contract := range config.Contracts {
+			addresses = append(addresses, strings.ToLower(contract.Hex()))
+		}
+		sort.Strings(addresses)
+		config.CheckpointKey = "lbbcert:" + strings.Join(addresses, ",")
+	}
+
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]common.Hash, 0, len(IndexedEventKinds))
+	for _, kind := range IndexedEventKinds {
+		event, ok := contractABI.Events[string(kind)]
+		if !ok {
+			return nil, fmt.Errorf("event %s not found in contract ABI", kind)
+		}
+		topics = append(topics, event.ID)
+	}
+
+	// Parsing logs does not need a backend
+	filterer, err := assets.NewLBBCertFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Indexer{
+		backend:  backend,
+		store:    store,
+		config:   config,
+		filterer: filterer,
+		topics:   topics,
+		recent:   make(map[uint64]indexedBlock),
+	}, nil
+}
+
+// NewIndexer returns an Indexer reading from the EVM RPC of this client
+func (e *EVMClient) NewIndexer(store CheckpointStore, config IndexerConfig) (*Indexer, error) {
+	return NewIndexer(e.GetClient().GetETHClient(), store, config)
+}
+
+// Checkpoint returns the last block fully processed by the indexer
+func (ix *Indexer) Checkpoint() (uint64, bool, error) {
+	return ix.store.LoadCheckpoint(ix.config.CheckpointKey)
+}
+
+// Sync scans from the checkpoint to the latest block once and returns the events found
+func (ix *Indexer) Sync(ctx context.Context) ([]ContractEvent, error) {
+	var events []ContractEvent
+	err := ix.scan(ctx, func(ev ContractEvent) error {
+		events = append(events, ev)
+		return nil
+	})
+	return events, err
+}
+
+// Run scans for new events every PollInterval and sends them to the channel until the context is cancelled
+// The checkpoint only advances once the events of a batch have been sent
+func (ix *Indexer) Run(ctx context.Context, events chan<- ContractEvent) error {
+	send := func(ev ContractEvent) error {
+		select {
+		case events <- ev:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	ticker := time.NewTicker(ix.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := ix.scan(ctx, send); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stream runs the indexer in the background
+// Both channels are closed when it stops; the error channel receives the reason unless the context was cancelled
+func (ix *Indexer) Stream(ctx context.Context) (<-chan ContractEvent, <-chan error) {
+	events := make(chan ContractEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		if err := ix.Run(ctx, events); err != nil && !errors.Is(err, context.Canceled) {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+func (ix *Indexer) scan(ctx context.Context, emit func(ContractEvent) error) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	head, err := ix.backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	checkpoint, found, err := ix.Checkpoint()
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+
+	from := ix.config.FromBlock
+	if found {
+		// Re-scan the safety window behind the checkpoint to pick up reorgs
+		from = checkpoint + 1
+		if from > ix.config.SafetyWindow {
+			from -= ix.config.SafetyWindow
+		} else {
+			from = 0
+		}
+		from = max(from, ix.config.FromBlock)
+	}
+
+	for start := from; start <= head; start += ix.config.BatchSize {
+		end := min(start+ix.config.BatchSize-1, head)
+
+		logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: ix.config.Contracts,
+			Topics:    [][]common.Hash{ix.topics},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to filter logs in blocks %d-%d: %w", start, end, err)
+		}
+
+		if err := ix.processBatch(ctx, start, end, head, logs, emit); err != nil {
+			return err
+		}
+
+		if !found || end > checkpoint {
+			if err := ix.store.SaveCheckpoint(ix.config.CheckpointKey, end); err != nil {
+				return fmt.Errorf("failed to save checkpoint: %w", err)
+			}
+		}
+	}
+
+	for number := range ix.recent {
+		if number+ix.config.SafetyWindow < head {
+			delete(ix.recent, number)
+		}
+	}
+
+	return nil
+}
+
+func (ix *Indexer) processBatch(ctx context.Context, start, end, head uint64, logs []types.Log, emit func(ContractEvent) error) error {
+	blockHashes := make(map[uint64]common.Hash)
+	for _, log := range logs {
+		blockHashes[log.BlockNumber] = log.BlockHash
+	}
+
+	// Retract the events of recorded blocks that are no longer canonical
+	recorded := make([]uint64, 0, len(ix.recent))
+	for number := range ix.recent {
+		if number >= start && number <= end {
+			recorded = append(recorded, number)
+		}
+	}
+	sort.Slice(recorded, func(i, j int) bool { return recorded[i] < recorded[j] })
+
+	for _, number := range recorded {
+		hash, ok := blockHashes[number]
+		if !ok {
+			header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+			if err != nil {
+				return fmt.Errorf("failed to get header %d: %w", number, err)
+			}
+			hash = header.Hash()
+		}
+
+		block := ix.recent[number]
+		if hash == block.hash {
+			continue
+		}
+
+		for i := len(block.events) - 1; i >= 0; i-- {
+			removed := block.events[i]
+			removed.Removed = true
+			if err := emit(removed); err != nil {
+				return err
+			}
+		}
+		delete(ix.recent, number)
+	}
+
+	blockTimes := make(map[common.Hash]uint64)
+	for _, log := range logs {
+		if block, ok := ix.recent[log.BlockNumber]; ok && block.hash == log.BlockHash && block.contains(log) {
+			continue
+		}
+
+		ev, err := ix.decode(log)
+		if err != nil {
+			return err
+		}
+
+		blockTime, ok := blockTimes[log.BlockHash]
+		if !ok {
+			header, err := ix.backend.HeaderByHash(ctx, log.BlockHash)
+			if err != nil {
+				return fmt.Errorf("failed to get header %s: %w", log.BlockHash.Hex(), err)
+			}
+			blockTime = header.Time
+			blockTimes[log.BlockHash] = blockTime
+		}
+		ev.BlockTime = blockTime
+
+		if err := emit(ev); err != nil {
+			return err
+		}
+
+		if log.BlockNumber+ix.config.SafetyWindow >= head {
+			block := ix.recent[log.BlockNumber]
+			block.hash = log.BlockHash
+			block.events = append(block.events, ev)
+			ix.recent[log.BlockNumber] = block
+		}
+	}
+
+	return nil
+}
+
+func (ix *Indexer) decode(log types.Log) (ContractEvent, error) {
+	ev := ContractEvent{
+		Contract:    log.Address,
+		BlockNumber: log.BlockNumber,
+		BlockHash:   log.BlockHash,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+
+	if len(log.Topics) == 0 {
+		return ev, fmt.Errorf("log %s#%d has no topics", log.TxHash.Hex(), log.Index)
+	}
+
+	var err error
+	switch log.Topics[0] {
+	case ix.topics[0]:
+		ev.Kind = EventTransfer
+		ev.Transfer, err = ix.filterer.ParseTransfer(log)
+	case ix.topics[1]:
+		ev.Kind = EventSafeMint
+		ev.SafeMint, err = ix.filterer.ParseSafeMintEvent(log)
+	case ix.topics[2]:
+		ev.Kind = EventPermitUsed
+		ev.PermitUsed, err = ix.filterer.ParsePermitUsed(log)
+	case ix.topics[3]:
+		ev.Kind = EventPermitForAllUsed
+		ev.PermitForAllUsed, err = ix.filterer.ParsePermitForAllUsed(log)
+	default:
+		return ev, fmt.Errorf("unexpected event topic %s in log %s#%d", log.Topics[0].Hex(), log.TxHash.Hex(), log.Index)
+	}
+	if err != nil {
+		return ev, fmt.Errorf("failed to decode %s in log %s#%d: %w", ev.Kind, log.TxHash.Hex(), log.Index, err)
+	}
+
+	return ev, nil
+}
+
+func (b indexedBlock) contains(log types.Log) bool {
+	for _, ev := range b.events {
+		if ev.TxHash == log.TxHash && ev.LogIndex == log.Index {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+var (
+	testContract = common.HexToAddress("0x00000000000000000000000000000000000c0de1")
+	testOwner    = common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+)
+
+// fakeChain is an in-memory chain of headers and logs for the indexer
+type fakeChain struct {
+	mu      sync.Mutex
+	headers map[uint64]*types.Header
+	logs    map[uint64][]types.Log
+	head    uint64
+	queries []ethereum.FilterQuery
+}
+
+func newFakeChain(head uint64) *fakeChain {
+	c := &fakeChain{
+		headers: make(map[uint64]*types.Header),
+		logs:    make(map[uint64][]types.Log),
+	}
+	for i := uint64(0); i <= head; i++ {
+		c.setBlock(i, 0)
+	}
+	c.head = head
+	return c
+}
+
+// setBlock replaces the block at number, fork changes its hash
+func (c *fakeChain) setBlock(number uint64, fork int64) {
+	c.headers[number] = &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Time:       1_700_000_000 + number*6,
+		Extra:      big.NewInt(fork).Bytes(),
+		Difficulty: big.NewInt(0),
+	}
+	delete(c.logs, number)
+}
+
+func (c *fakeChain) addLog(number uint64, topics []common.Hash, data []byte) {
+	hash := c.headers[number].Hash()
+	c.logs[number] = append(c.logs[number], types.Log{
+		Address:     testContract,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: number,
+		BlockHash:   hash,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(number*1000 + uint64(len(c.logs[number])))),
+		Index:       uint(len(c.logs[number])),
+	})
+}
+
+func (c *fakeChain) addMint(t *testing.T, number uint64, to common.Address, tokenID int64) {
+	t.Helper()
+
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	require.NoError(t, err)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.addLog(number, []common.Hash{
+		contractABI.Events["Transfer"].ID,
+		common.Hash{},
+		common.BytesToHash(to.Bytes()),
+		common.BigToHash(big.NewInt(tokenID)),
+	}, nil)
+
+	data, err := contractABI.Events["safeMintEvent"].Inputs.NonIndexed().Pack(to, big.NewInt(tokenID))
+	require.NoError(t, err)
+	c.addLog(number, []common.Hash{contractABI.Events["safeMintEvent"].ID}, data)
+}
+
+func (c *fakeChain) BlockNumber(context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.head, nil
+}
+
+func (c *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	header, ok := c.headers[number.Uint64()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+func (c *fakeChain) HeaderByHash(_ context.Context, hash common.Hash) (*types.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header, nil
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *fakeChain) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, query)
+
+	var logs []types.Log
+	for number := query.FromBlock.Uint64(); number <= query.ToBlock.Uint64(); number++ {
+		logs = append(logs, c.logs[number]...)
+	}
+	return logs, nil
+}
+
+func TestIndexerSync(t *testing.T) {
+	ctx := context.Background()
+	chain := newFakeChain(30)
+	chain.addMint(t, 5, testOwner, 1)
+
+	store := evm.NewMemoryCheckpointStore()
+	indexer, err := evm.NewIndexer(chain, store, evm.IndexerConfig{
+		Contracts:    []common.Address{testContract},
+		BatchSize:    10,
+		SafetyWindow: 4,
+	})
+	require.NoError(t, err)
+
+	t.Run("Decode events from the start block", func(t *testing.T) {
+		events, err := indexer.Sync(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		assert.Equal(t, evm.EventTransfer, events[0].Kind)
+		assert.True(t, events[0].IsMint())
+		assert.Equal(t, testOwner, events[0].Transfer.To)
+		assert.Equal(t, int64(1), events[0].Transfer.TokenId.Int64())
+		assert.Equal(t, uint64(1_700_000_000+5*6), events[0].BlockTime)
+
+		assert.Equal(t, evm.EventSafeMint, events[1].Kind)
+		assert.Equal(t, testOwner, events[1].SafeMint.To)
+
+		checkpoint, found, err := indexer.Checkpoint()
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, uint64(30), checkpoint)
+
+		for _, query := range chain.queries {
+			assert.LessOrEqual(t, query.ToBlock.Uint64()-query.FromBlock.Uint64(), uint64(9), "Batch must stay within the batch size")
+		}
+	})
+
+	t.Run("Nothing new is delivered twice", func(t *testing.T) {
+		chain.addMint(t, 29, testOwner, 2)
+
+		events, err := indexer.Sync(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 2, "Event inside the safety window should be found")
+
+		events, err = indexer.Sync(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Reorg retracts and replaces events", func(t *testing.T) {
+		chain.mu.Lock()
+		chain.setBlock(29, 1)
+		chain.mu.Unlock()
+		chain.addMint(t, 29, testOwner, 3)
+
+		events, err := indexer.Sync(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 4)
+
+		assert.True(t, events[0].Removed)
+		assert.Equal(t, evm.EventSafeMint, events[0].Kind)
+		assert.True(t, events[1].Removed)
+		assert.Equal(t, int64(2), events[1].Transfer.TokenId.Int64())
+
+		assert.False(t, events[2].Removed)
+		assert.Equal(t, int64(3), events[2].Transfer.TokenId.Int64())
+	})
+
+	t.Run("Reorg dropping every event of a block", func(t *testing.T) {
+		chain.mu.Lock()
+		chain.setBlock(29, 2)
+		chain.mu.Unlock()
+
+		events, err := indexer.Sync(ctx)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.True(t, events[0].Removed)
+		assert.True(t, events[1].Removed)
+	})
+}
+
+func TestIndexerStream(t *testing.T) {
+	chain := newFakeChain(10)
+	chain.addMint(t, 3, testOwner, 7)
+
+	indexer, err := evm.NewIndexer(chain, evm.NewMemoryCheckpointStore(), evm.IndexerConfig{
+		Contracts:    []common.Address{testContract},
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := indexer.Stream(ctx)
+
+	first := <-events
+	assert.Equal(t, evm.EventTransfer, first.Kind)
+	<-events
+
+	// New blocks are picked up on the next poll
+	chain.mu.Lock()
+	for i := uint64(11); i <= 12; i++ {
+		chain.setBlock(i, 0)
+	}
+	chain.head = 12
+	chain.mu.Unlock()
+	chain.addMint(t, 12, testOwner, 8)
+
+	select {
+	case ev := <-events:
+		assert.Equal(t, int64(8), ev.Transfer.TokenId.Int64())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for streamed event")
+	}
+
+	cancel()
+	for range events {
+	}
+	assert.NoError(t, <-errs)
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+
+	store, err := evm.NewFileCheckpointStore(path)
+	require.NoError(t, err)
+
+	_, found, err := store.LoadCheckpoint("a")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.SaveCheckpoint("a", 10))
+	require.NoError(t, store.SaveCheckpoint("b", 20))
+
+	reopened, err := evm.NewFileCheckpointStore(path)
+	require.NoError(t, err)
+
+	block, found, err := reopened.LoadCheckpoint("a")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(10), block)
+
+	block, _, err = reopened.LoadCheckpoint("b")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), block)
+}
@@ -0,0 +1,98 @@
+package evm
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ERC4906InterfaceID is the ERC-165 interface ID of ERC-4906 metadata update events
+var ERC4906InterfaceID = [4]byte{0x49, 0x06, 0x49, 0x06}
+
+// ErrMetadataUpdateUnsupported is returned for contracts that cannot emit ERC-4906 events, such as an LBBCert deployed from bytecode older than them
+var ErrMetadataUpdateUnsupported = errors.New("contract does not implement ERC-4906")
+
+// MetadataUpdateMetaData holds the ABI of the ERC-4906 events and the certificate functions that emit them
+var MetadataUpdateMetaData = &bind.MetaData{
+	ABI: `[{"type":"function","name":"emitMetadataUpdate","stateMutability":"nonpayable","inputs":[{"name":"tokenId","type":"uint256"}],"outputs":[]},` +
+		`{"type":"function","name":"emitBatchMetadataUpdate","stateMutability":"nonpayable","inputs":[{"name":"fromTokenId","type":"uint256"},{"name":"toTokenId","type":"uint256"}],"outputs":[]},` +
+		`{"type":"event","name":"MetadataUpdate","anonymous":false,"inputs":[{"name":"_tokenId","type":"uint256","indexed":false}]},` +
+		`{"type":"event","name":"BatchMetadataUpdate","anonymous":false,"inputs":[{"name":"_fromTokenId","type":"uint256","indexed":false},{"name":"_toTokenId","type":"uint256","indexed":false}]},` +
+		`{"type":"error","name":"ERC721NonexistentToken","inputs":[{"name":"tokenId","type":"uint256"}]},` +
+		`{"type":"error","name":"OwnableUnauthorizedAccount","inputs":[{"name":"account","type":"address"}]},` +
+		`{"type":"error","name":"AccessControlUnauthorizedAccount","inputs":[{"name":"account","type":"address"},{"name":"neededRole","type":"bytes32"}]}]`,
+}
+
+// NewMetadataUpdateHook returns an action hook for metadata.MetadataMsg.WithActionHook
+// After each confirmed nftmngr action it sends emitMetadataUpdate for the same token through contract and waits for it
+// The contract handle must use the ABI of MetadataUpdateMetaData
+func NewMetadataUpdateHook(contract *ContractHandle) func(tokenID string, action string) error {
+	return func(tokenID string, action string) error {
+		id, ok := new(big.Int).SetString(tokenID, 10)
+		if !ok || id.Sign() < 0 {
+			return fmt.Errorf("token ID %q of %s is not a number", tokenID, action)
+		}
+
+		if _, err := contract.TransactAndWait("emitMetadataUpdate", id); err != nil {
+			return fmt.Errorf("failed to refresh metadata of token %s after %s: %w", tokenID, action, err)
+		}
+
+		return nil
+	}
+}
+
+// MetadataUpdateHook returns an action hook emitting MetadataUpdate on contractAddress, see NewMetadataUpdateHook
+func (e *EVMClient) MetadataUpdateHook(contractAddress common.Address) (func(tokenID string, action string) error, error) {
+	contract, err := e.metadataUpdateHandle(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMetadataUpdateHook(contract), nil
+}
+
+// EmitMetadataUpdate tells marketplaces and indexers to refetch the tokenURI of tokenID
+func (e *EVMClient) EmitMetadataUpdate(contractAddress common.Address, tokenID uint64) (*types.Transaction, error) {
+	contract, err := e.metadataUpdateHandle(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.Transact("emitMetadataUpdate", new(big.Int).SetUint64(tokenID))
+}
+
+// EmitBatchMetadataUpdate tells marketplaces and indexers to refetch the tokenURI of every token from fromTokenID to toTokenID
+func (e *EVMClient) EmitBatchMetadataUpdate(contractAddress common.Address, fromTokenID, toTokenID uint64) (*types.Transaction, error) {
+	if fromTokenID > toTokenID {
+		return nil, fmt.Errorf("invalid token range %d to %d", fromTokenID, toTokenID)
+	}
+
+	contract, err := e.metadataUpdateHandle(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.Transact("emitBatchMetadataUpdate", new(big.Int).SetUint64(fromTokenID), new(big.Int).SetUint64(toTokenID))
+}
+
+// metadataUpdateHandle fails early with ErrMetadataUpdateUnsupported on contracts without ERC-4906
+func (e *EVMClient) metadataUpdateHandle(contractAddress common.Address) (*ContractHandle, error) {
+	supported, err := SupportsInterface(e.GetClient().GetContext(), e.Backend(), contractAddress, ERC4906InterfaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, fmt.Errorf("%w: %s", ErrMetadataUpdateUnsupported, contractAddress.Hex())
+	}
+
+	contractABI, err := MetadataUpdateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.Contract(contractAddress, *contractABI)
+}
@@ -0,0 +1,92 @@
+package evm_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/certv2"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/roles"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/soulbound"
+)
+
+func TestMetadataUpdateHook(t *testing.T) {
+	contractABI, err := evm.MetadataUpdateMetaData.GetAbi()
+	require.NoError(t, err)
+
+	t.Run("Certificate contracts emit ERC-4906 events", func(t *testing.T) {
+		for _, metaData := range []*bind.MetaData{certv2.LBBCertV2MetaData, soulbound.LBBCertSoulboundMetaData, roles.LBBCertRolesMetaData} {
+			certABI, err := metaData.GetAbi()
+			require.NoError(t, err)
+
+			for name, event := range contractABI.Events {
+				assert.Equal(t, event.ID, certABI.Events[name].ID, name)
+			}
+			for name, method := range contractABI.Methods {
+				assert.Equal(t, method.ID, certABI.Methods[name].ID, name)
+			}
+		}
+	})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	newHook := func(t *testing.T, backend *fakeContractBackend, waitErr error) func(tokenID string, action string) error {
+		handle, err := evm.NewContractHandle(testContract, *contractABI, backend)
+		require.NoError(t, err)
+
+		handle = handle.
+			WithTransactOpts(func() (*bind.TransactOpts, error) {
+				opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(666))
+				if err != nil {
+					return nil, err
+				}
+				opts.GasPrice = big.NewInt(1_000_000_000_000)
+				return opts, nil
+			}).
+			WithWait(func(txHash common.Hash) (*types.Receipt, error) {
+				if waitErr != nil {
+					return &types.Receipt{Status: types.ReceiptStatusFailed, TxHash: txHash}, waitErr
+				}
+				return &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: txHash}, nil
+			})
+
+		return evm.NewMetadataUpdateHook(handle)
+	}
+
+	t.Run("Refreshes the token of the action", func(t *testing.T) {
+		backend := &fakeContractBackend{token: newFakeERC721(t)}
+
+		require.NoError(t, newHook(t, backend, nil)("42", "freeze_cert"))
+
+		require.Len(t, backend.sent, 1)
+		data, err := contractABI.Pack("emitMetadataUpdate", big.NewInt(42))
+		require.NoError(t, err)
+		assert.Equal(t, data, backend.sent[0].Data())
+	})
+
+	t.Run("Failed refresh names the action", func(t *testing.T) {
+		backend := &fakeContractBackend{token: newFakeERC721(t)}
+
+		err := newHook(t, backend, errors.New("transaction failed"))("42", "unfreeze_cert")
+		assert.ErrorContains(t, err, "after unfreeze_cert")
+		assert.ErrorContains(t, err, "transaction failed")
+	})
+
+	t.Run("Token IDs that are not numbers", func(t *testing.T) {
+		backend := &fakeContractBackend{token: newFakeERC721(t)}
+
+		for _, tokenID := range []string{"", "cert-1", "-1"} {
+			assert.Error(t, newHook(t, backend, nil)(tokenID, "freeze_cert"), tokenID)
+		}
+		assert.Empty(t, backend.sent)
+	})
+}
@@ -0,0 +1,427 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// DefaultMintBatchGasLimit is the gas a single safeMintBatch transaction may use
+const DefaultMintBatchGasLimit = 8_000_000
+
+// initialMintBatchSize is the size of the first chunk, later chunks are sized by its gas per token
+const initialMintBatchSize = 50
+
+// MintBatchMetaData holds the ABI used to mint in batches and to decode the minted tokens
+var MintBatchMetaData = &bind.MetaData{
+	ABI: `[{"type":"function","name":"safeMint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}],"outputs":[]},` +
+		`{"type":"function","name":"safeMintBatch","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address[]"},{"name":"ids","type":"uint256[]"}],"outputs":[]},` +
+		`{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]},` +
+		`{"type":"error","name":"BatchLengthMismatch","inputs":[{"name":"recipients","type":"uint256"},{"name":"tokenIds","type":"uint256"}]},` +
+		`{"type":"error","name":"ERC721InvalidSender","inputs":[{"name":"sender","type":"address"}]},` +
+		`{"type":"error","name":"ERC721InvalidReceiver","inputs":[{"name":"receiver","type":"address"}]},` +
+		`{"type":"error","name":"OwnableUnauthorizedAccount","inputs":[{"name":"account","type":"address"}]},` +
+		`{"type":"error","name":"AccessControlUnauthorizedAccount","inputs":[{"name":"account","type":"address"},{"name":"neededRole","type":"bytes32"}]}]`,
+}
+
+// MintRequest is one token of a batch mint
+type MintRequest struct {
+	TokenID   uint64
+	Recipient common.Address
+}
+
+// MintStatus is the outcome of one token of a batch mint
+type MintStatus string
+
+const (
+	// MintStatusMinted tokens have a Transfer event from the zero address in the receipt of TxHash
+	MintStatusMinted MintStatus = "minted"
+	// MintStatusSkipped tokens were already minted before the batch and were not sent
+	MintStatusSkipped MintStatus = "skipped"
+	// MintStatusFailed tokens were not minted, Err tells why
+	MintStatusFailed MintStatus = "failed"
+)
+
+// MintResult is the outcome of one MintRequest, results are in the order of the requests
+type MintResult struct {
+	TokenID   uint64
+	Recipient common.Address
+	Status    MintStatus
+	// TxHash is the transaction that minted the token, or the one that failed to
+	TxHash common.Hash
+	Err    error
+}
+
+// BatchMinter mints many tokens in gas-bounded safeMintBatch(to[], ids[]) transactions
+// Contracts without safeMintBatch, such as LBBCert deployed from bytecode older than it, get one safeMint transaction per token
+// CertAutoID chooses its own token IDs and is not supported, its safeMintBatch(to[]) takes recipients only
+// Every transaction is sent before the first receipt is awaited, with consecutive nonces
+type BatchMinter struct {
+	ctx          context.Context
+	address      common.Address
+	backend      bind.ContractBackend
+	handle       *ContractHandle
+	transactOpts func() (*bind.TransactOpts, error)
+	wait         func(txHash common.Hash) (*types.Receipt, error)
+	isMinted     func(tokenID uint64) (bool, error)
+	gasLimit     uint64
+}
+
+// mintBatchTx is a sent transaction and the requests it mints
+type mintBatchTx struct {
+	hash    common.Hash
+	pending []int
+}
+
+// NewBatchMinter returns a BatchMinter on the contract at address, it needs transact options and a wait function to mint
+func NewBatchMinter(ctx context.Context, address common.Address, backend bind.ContractBackend) (*BatchMinter, error) {
+	contractABI, err := MintBatchMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := NewContractHandle(address, *contractABI, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchMinter{
+		ctx:      ctx,
+		address:  address,
+		backend:  backend,
+		handle:   handle,
+		gasLimit: DefaultMintBatchGasLimit,
+	}, nil
+}
+
+// BatchMinter returns a BatchMinter on a certificate contract using the account and fee strategy of this client
+func (e *EVMClient) BatchMinter(contractAddress common.Address) (*BatchMinter, error) {
+	minter, err := NewBatchMinter(e.GetClient().GetContext(), contractAddress, e.Backend())
+	if err != nil {
+		return nil, err
+	}
+
+	return minter.
+		WithTransactOpts(e.TransactOpts).
+		WithWait(e.GetClient().WaitForEVMTransaction).
+		WithMintedCheck(func(tokenID uint64) (bool, error) {
+			return e.IsMinted(contractAddress, tokenID)
+		}), nil
+}
+
+// MintBatch mints every request, skipping tokens already minted, and returns the outcome of each token
+// On a role-based contract this account needs MINTER_ROLE
+func (e *EVMClient) MintBatch(contractAddress common.Address, requests []MintRequest) ([]MintResult, error) {
+	if err := e.requireMinter(contractAddress); err != nil {
+		return nil, err
+	}
+
+	minter, err := e.BatchMinter(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return minter.Mint(requests)
+}
+
+// WithTransactOpts returns a new BatchMinter signing with options from the given function
+// The function is called once per Mint, the nonce is then counted up locally
+func (m *BatchMinter) WithTransactOpts(opts func() (*bind.TransactOpts, error)) *BatchMinter {
+	newMinter := *m
+	newMinter.transactOpts = opts
+	return &newMinter
+}
+
+// WithWait returns a new BatchMinter waiting for receipts with the given function
+func (m *BatchMinter) WithWait(wait func(txHash common.Hash) (*types.Receipt, error)) *BatchMinter {
+	newMinter := *m
+	newMinter.wait = wait
+	return &newMinter
+}
+
+// WithMintedCheck returns a new BatchMinter skipping the tokens for which isMinted is true
+// Without it, already minted tokens fail when their gas is estimated
+func (m *BatchMinter) WithMintedCheck(isMinted func(tokenID uint64) (bool, error)) *BatchMinter {
+	newMinter := *m
+	newMinter.isMinted = isMinted
+	return &newMinter
+}
+
+// WithGasLimit returns a new BatchMinter keeping each safeMintBatch transaction under gasLimit
+func (m *BatchMinter) WithGasLimit(gasLimit uint64) *BatchMinter {
+	newMinter := *m
+	if gasLimit > 0 {
+		newMinter.gasLimit = gasLimit
+	}
+	return &newMinter
+}
+
+// SupportsBatch reports whether the contract has safeMintBatch and lets this account call it
+// It estimates an empty batch, which reverts on contracts without the function
+func (m *BatchMinter) SupportsBatch(from common.Address) (bool, error) {
+	_, err := m.estimate(from, "safeMintBatch", []common.Address{}, []*big.Int{})
+	if err != nil {
+		// Reverts without data, as from a contract with no fallback, stay an rpc.DataError
+		var revert *RevertError
+		var dataErr rpc.DataError
+		if errors.As(err, &revert) || errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Mint sends the requests and waits for every receipt
+// A token that cannot be minted fails alone, the returned error is for failures before anything is sent
+func (m *BatchMinter) Mint(requests []MintRequest) ([]MintResult, error) {
+	if m.transactOpts == nil {
+		return nil, fmt.Errorf("batch minter has no transact options")
+	}
+	if m.wait == nil {
+		return nil, fmt.Errorf("batch minter cannot wait for receipts")
+	}
+
+	results := make([]MintResult, len(requests))
+	pending, err := m.filter(requests, results)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	opts, err := m.transactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := opts.Nonce
+	if nonce == nil {
+		pendingNonce, err := m.backend.PendingNonceAt(m.ctx, opts.From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		nonce = new(big.Int).SetUint64(pendingNonce)
+	}
+
+	batch, err := m.SupportsBatch(opts.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent []mintBatchTx
+	if batch {
+		sent = m.sendBatches(opts, nonce, requests, pending, results)
+	} else {
+		sent = m.sendSingles(opts, nonce, requests, pending, results)
+	}
+
+	for _, tx := range sent {
+		m.collect(tx, requests, results)
+	}
+
+	return results, nil
+}
+
+// filter fills the results of invalid, repeated and already minted requests and returns the indexes left to mint
+func (m *BatchMinter) filter(requests []MintRequest, results []MintResult) ([]int, error) {
+	seen := make(map[uint64]bool, len(requests))
+	var pending []int
+	for i, request := range requests {
+		results[i] = MintResult{TokenID: request.TokenID, Recipient: request.Recipient}
+
+		switch {
+		case request.Recipient == (common.Address{}):
+			results[i].Status = MintStatusFailed
+			results[i].Err = fmt.Errorf("token %d has no recipient", request.TokenID)
+			continue
+		case seen[request.TokenID]:
+			results[i].Status = MintStatusFailed
+			results[i].Err = fmt.Errorf("token %d is requested more than once", request.TokenID)
+			continue
+		}
+		seen[request.TokenID] = true
+
+		if m.isMinted != nil {
+			minted, err := m.isMinted(request.TokenID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check token %d: %w", request.TokenID, err)
+			}
+			if minted {
+				results[i].Status = MintStatusSkipped
+				continue
+			}
+		}
+
+		pending = append(pending, i)
+	}
+
+	return pending, nil
+}
+
+// sendBatches sends safeMintBatch transactions, sizing each chunk by the gas per token of the previous one
+// A chunk whose estimate reverts is halved until the failing token is alone
+func (m *BatchMinter) sendBatches(opts *bind.TransactOpts, nonce *big.Int, requests []MintRequest, pending []int, results []MintResult) []mintBatchTx {
+	var sent []mintBatchTx
+	size := initialMintBatchSize
+	for len(pending) > 0 {
+		size = min(size, len(pending))
+		recipients, tokenIDs := mintBatchArgs(requests, pending[:size])
+
+		gas, err := m.estimate(opts.From, "safeMintBatch", recipients, tokenIDs)
+		switch {
+		case err != nil && size > 1:
+			size /= 2
+			continue
+		case err != nil:
+			m.fail(results, pending[:1], common.Hash{}, err)
+			pending = pending[1:]
+			continue
+		case gas > m.gasLimit && size > 1:
+			size = max(1, int(uint64(size)*m.gasLimit/gas))
+			continue
+		}
+
+		hash, err := m.send(opts, nonce, gas, "safeMintBatch", recipients, tokenIDs)
+		if err != nil {
+			// Later transactions would leave a nonce gap, so nothing more is sent
+			m.fail(results, pending, common.Hash{}, err)
+			return sent
+		}
+
+		sent = append(sent, mintBatchTx{hash: hash, pending: pending[:size]})
+		pending = pending[size:]
+		nonce = new(big.Int).Add(nonce, big.NewInt(1))
+		size = max(1, int(uint64(size)*m.gasLimit/gas))
+	}
+
+	return sent
+}
+
+// sendSingles sends one safeMint transaction per token
+func (m *BatchMinter) sendSingles(opts *bind.TransactOpts, nonce *big.Int, requests []MintRequest, pending []int, results []MintResult) []mintBatchTx {
+	var sent []mintBatchTx
+	for n, i := range pending {
+		tokenID := new(big.Int).SetUint64(requests[i].TokenID)
+
+		gas, err := m.estimate(opts.From, "safeMint", requests[i].Recipient, tokenID)
+		if err != nil {
+			m.fail(results, pending[n:n+1], common.Hash{}, err)
+			continue
+		}
+
+		hash, err := m.send(opts, nonce, gas, "safeMint", requests[i].Recipient, tokenID)
+		if err != nil {
+			m.fail(results, pending[n:], common.Hash{}, err)
+			return sent
+		}
+
+		sent = append(sent, mintBatchTx{hash: hash, pending: pending[n : n+1]})
+		nonce = new(big.Int).Add(nonce, big.NewInt(1))
+	}
+
+	return sent
+}
+
+// collect waits for the receipt of tx and sets the results of its tokens from the Transfer events
+func (m *BatchMinter) collect(tx mintBatchTx, requests []MintRequest, results []MintResult) {
+	receipt, err := m.wait(tx.hash)
+	if err != nil {
+		m.fail(results, tx.pending, tx.hash, err)
+		return
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		m.fail(results, tx.pending, tx.hash, fmt.Errorf("transaction %s reverted", tx.hash.Hex()))
+		return
+	}
+
+	events, err := m.handle.DecodeEvents(receipt)
+	if err != nil {
+		m.fail(results, tx.pending, tx.hash, err)
+		return
+	}
+
+	minted := make(map[uint64]common.Address)
+	for _, event := range events {
+		if event.Name != "Transfer" || event.Fields["from"].(common.Address) != (common.Address{}) {
+			continue
+		}
+		tokenID := event.Fields["tokenId"].(*big.Int)
+		if tokenID.IsUint64() {
+			minted[tokenID.Uint64()] = event.Fields["to"].(common.Address)
+		}
+	}
+
+	for _, i := range tx.pending {
+		recipient, ok := minted[requests[i].TokenID]
+		if !ok {
+			m.fail(results, []int{i}, tx.hash, fmt.Errorf("transaction %s has no mint of token %d", tx.hash.Hex(), requests[i].TokenID))
+			continue
+		}
+		results[i].Status = MintStatusMinted
+		results[i].Recipient = recipient
+		results[i].TxHash = tx.hash
+	}
+}
+
+func (m *BatchMinter) fail(results []MintResult, pending []int, txHash common.Hash, err error) {
+	for _, i := range pending {
+		results[i].Status = MintStatusFailed
+		results[i].TxHash = txHash
+		results[i].Err = err
+	}
+}
+
+// estimate returns the gas of a call, reverts are returned as RevertError
+func (m *BatchMinter) estimate(from common.Address, method string, args ...interface{}) (uint64, error) {
+	contractABI := m.handle.ABI()
+
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	gas, err := m.backend.EstimateGas(m.ctx, ethereum.CallMsg{From: from, To: &m.address, Data: data})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate %s: %w", method, decodeRPCError(&contractABI, err))
+	}
+
+	return gas, nil
+}
+
+// send signs a transaction with a fixed nonce and gas limit, so that no estimate or nonce lookup is repeated
+func (m *BatchMinter) send(opts *bind.TransactOpts, nonce *big.Int, gas uint64, method string, args ...interface{}) (common.Hash, error) {
+	txOpts := *opts
+	txOpts.Nonce = nonce
+	txOpts.GasLimit = gas
+
+	handle := m.handle.WithTransactOpts(func() (*bind.TransactOpts, error) {
+		return &txOpts, nil
+	})
+
+	tx, err := handle.Transact(method, args...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func mintBatchArgs(requests []MintRequest, pending []int) ([]common.Address, []*big.Int) {
+	recipients := make([]common.Address, len(pending))
+	tokenIDs := make([]*big.Int, len(pending))
+	for n, i := range pending {
+		recipients[n] = requests[i].Recipient
+		tokenIDs[n] = new(big.Int).SetUint64(requests[i].TokenID)
+	}
+	return recipients, tokenIDs
+}
@@ -0,0 +1,254 @@
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// fakeMintChain estimates, mines and receipts safeMint and safeMintBatch transactions
+type fakeMintChain struct {
+	bind.ContractBackend
+	t   *testing.T
+	abi *abi.ABI
+	// batch is false for contracts without safeMintBatch
+	batch  bool
+	minted map[uint64]bool
+	// taken tokens are minted by someone else between the estimate and the transaction
+	taken    map[uint64]bool
+	sent     []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newFakeMintChain(t *testing.T, batch bool, minted ...uint64) *fakeMintChain {
+	contractABI, err := evm.MintBatchMetaData.GetAbi()
+	require.NoError(t, err)
+
+	chain := &fakeMintChain{
+		t:        t,
+		abi:      contractABI,
+		batch:    batch,
+		minted:   make(map[uint64]bool),
+		taken:    make(map[uint64]bool),
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+	for _, tokenID := range minted {
+		chain.minted[tokenID] = true
+	}
+	return chain
+}
+
+func (f *fakeMintChain) isMinted(tokenID uint64) (bool, error) {
+	return f.minted[tokenID], nil
+}
+
+// decode returns the recipients and token IDs of a mint call
+func (f *fakeMintChain) decode(data []byte) (string, []common.Address, []*big.Int) {
+	method, err := f.abi.MethodById(data[:4])
+	require.NoError(f.t, err)
+
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(f.t, err)
+
+	if method.Name == "safeMint" {
+		return method.Name, []common.Address{args[0].(common.Address)}, []*big.Int{args[1].(*big.Int)}
+	}
+	return method.Name, args[0].([]common.Address), args[1].([]*big.Int)
+}
+
+func (f *fakeMintChain) EstimateGas(_ context.Context, call ethereum.CallMsg) (uint64, error) {
+	name, _, tokenIDs := f.decode(call.Data)
+	if name == "safeMintBatch" && !f.batch {
+		return 0, revertRPCError{}
+	}
+
+	for _, tokenID := range tokenIDs {
+		if f.minted[tokenID.Uint64()] {
+			customError := f.abi.Errors["ERC721InvalidSender"]
+			data, err := customError.Inputs.Pack(common.Address{})
+			require.NoError(f.t, err)
+			return 0, revertRPCError{data: append(customError.ID[:4:4], data...)}
+		}
+	}
+
+	return 50_000 + 100_000*uint64(len(tokenIDs)), nil
+}
+
+func (f *fakeMintChain) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return 7, nil
+}
+
+func (f *fakeMintChain) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	f.sent = append(f.sent, tx)
+
+	_, recipients, tokenIDs := f.decode(tx.Data())
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash()}
+	for _, tokenID := range tokenIDs {
+		if f.taken[tokenID.Uint64()] {
+			receipt = &types.Receipt{Status: types.ReceiptStatusFailed, TxHash: tx.Hash()}
+			break
+		}
+	}
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		for i, tokenID := range tokenIDs {
+			f.minted[tokenID.Uint64()] = true
+			receipt.Logs = append(receipt.Logs, &types.Log{
+				Address: testContract,
+				Topics: []common.Hash{
+					f.abi.Events["Transfer"].ID,
+					{},
+					common.BytesToHash(recipients[i].Bytes()),
+					common.BigToHash(tokenID),
+				},
+			})
+		}
+	}
+
+	f.receipts[tx.Hash()] = receipt
+	return nil
+}
+
+func (f *fakeMintChain) wait(txHash common.Hash) (*types.Receipt, error) {
+	receipt, ok := f.receipts[txHash]
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found", txHash.Hex())
+	}
+	return receipt, nil
+}
+
+func TestMintBatch(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	recipient := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	requests := func(tokenIDs ...uint64) []evm.MintRequest {
+		var requests []evm.MintRequest
+		for _, tokenID := range tokenIDs {
+			requests = append(requests, evm.MintRequest{TokenID: tokenID, Recipient: recipient})
+		}
+		return requests
+	}
+
+	newMinter := func(t *testing.T, chain *fakeMintChain) *evm.BatchMinter {
+		minter, err := evm.NewBatchMinter(context.Background(), testContract, chain)
+		require.NoError(t, err)
+
+		return minter.
+			WithTransactOpts(func() (*bind.TransactOpts, error) {
+				opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(666))
+				if err != nil {
+					return nil, err
+				}
+				opts.GasPrice = big.NewInt(1_000_000_000_000)
+				return opts, nil
+			}).
+			WithWait(chain.wait).
+			WithMintedCheck(chain.isMinted)
+	}
+
+	statuses := func(results []evm.MintResult) map[uint64]evm.MintStatus {
+		statuses := make(map[uint64]evm.MintStatus)
+		for _, result := range results {
+			statuses[result.TokenID] = result.Status
+		}
+		return statuses
+	}
+
+	t.Run("Chunks are bounded by gas", func(t *testing.T) {
+		chain := newFakeMintChain(t, true, 3)
+
+		// Room for four tokens per transaction
+		results, err := newMinter(t, chain).WithGasLimit(450_000).Mint(requests(1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+		require.NoError(t, err)
+		require.Len(t, results, 10)
+
+		require.Len(t, chain.sent, 3)
+		for i, tx := range chain.sent {
+			assert.Equal(t, uint64(7+i), tx.Nonce())
+			assert.LessOrEqual(t, tx.Gas(), uint64(450_000))
+		}
+
+		for _, result := range results {
+			if result.TokenID == 3 {
+				assert.Equal(t, evm.MintStatusSkipped, result.Status)
+				continue
+			}
+			assert.Equal(t, evm.MintStatusMinted, result.Status, "token %d", result.TokenID)
+			assert.Equal(t, recipient, result.Recipient)
+			assert.NotEqual(t, common.Hash{}, result.TxHash)
+			assert.NoError(t, result.Err)
+		}
+		assert.Equal(t, chain.sent[0].Hash(), results[0].TxHash)
+		assert.Equal(t, chain.sent[2].Hash(), results[9].TxHash)
+	})
+
+	t.Run("Contract without safeMintBatch", func(t *testing.T) {
+		chain := newFakeMintChain(t, false)
+
+		results, err := newMinter(t, chain).Mint(requests(1, 2, 3))
+		require.NoError(t, err)
+
+		require.Len(t, chain.sent, 3)
+		for i, tx := range chain.sent {
+			assert.Equal(t, uint64(7+i), tx.Nonce())
+			assert.Equal(t, chain.abi.Methods["safeMint"].ID, tx.Data()[:4])
+			assert.Equal(t, tx.Hash(), results[i].TxHash)
+		}
+		assert.Equal(t, map[uint64]evm.MintStatus{1: evm.MintStatusMinted, 2: evm.MintStatusMinted, 3: evm.MintStatusMinted}, statuses(results))
+	})
+
+	t.Run("Tokens that cannot be minted fail alone", func(t *testing.T) {
+		chain := newFakeMintChain(t, true)
+
+		// The minted check misses token 2, so its estimate reverts
+		minter := newMinter(t, chain).WithMintedCheck(nil)
+		chain.minted[2] = true
+
+		results, err := minter.Mint(append(requests(1, 2, 3, 1), evm.MintRequest{TokenID: 4}))
+		require.NoError(t, err)
+
+		assert.Equal(t, evm.MintStatusMinted, results[0].Status)
+		assert.Equal(t, evm.MintStatusFailed, results[1].Status)
+		var revert *evm.RevertError
+		require.ErrorAs(t, results[1].Err, &revert)
+		assert.Equal(t, "ERC721InvalidSender", revert.Name)
+		assert.Equal(t, evm.MintStatusMinted, results[2].Status)
+		assert.ErrorContains(t, results[3].Err, "more than once")
+		assert.ErrorContains(t, results[4].Err, "no recipient")
+	})
+
+	t.Run("Reverted transaction fails its chunk", func(t *testing.T) {
+		chain := newFakeMintChain(t, true)
+		chain.taken[2] = true
+
+		results, err := newMinter(t, chain).WithGasLimit(250_000).Mint(requests(1, 2, 3))
+		require.NoError(t, err)
+
+		require.Len(t, chain.sent, 2)
+		assert.Equal(t, map[uint64]evm.MintStatus{1: evm.MintStatusFailed, 2: evm.MintStatusFailed, 3: evm.MintStatusMinted}, statuses(results))
+		assert.Equal(t, chain.sent[0].Hash(), results[1].TxHash)
+		assert.ErrorContains(t, results[0].Err, "reverted")
+	})
+
+	t.Run("Nothing to mint", func(t *testing.T) {
+		chain := newFakeMintChain(t, true, 1, 2)
+
+		results, err := newMinter(t, chain).Mint(requests(1, 2))
+		require.NoError(t, err)
+		assert.Empty(t, chain.sent)
+		assert.Equal(t, map[uint64]evm.MintStatus{1: evm.MintStatusSkipped, 2: evm.MintStatusSkipped}, statuses(results))
+	})
+}
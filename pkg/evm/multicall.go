@@ -0,0 +1,297 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Multicall3Address is the deterministic deployment address of Multicall3 on every chain that has it
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// DefaultMulticallBatchSize is the number of calls aggregated into one Multicall3 call
+const DefaultMulticallBatchSize = 500
+
+// Multicall3MetaData holds the ABI of the Multicall3 aggregate3 function
+var Multicall3MetaData = &bind.MetaData{
+	ABI: `[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`,
+}
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// Call is one contract read in a batch
+// ABI and Method pack the call and unpack its result; Data may be set instead to send raw call data
+type Call struct {
+	Target common.Address
+	ABI    *abi.ABI
+	Method string
+	Args   []interface{}
+	Data   []byte
+}
+
+// CallResult is the outcome of one Call, a failing call does not fail the batch
+type CallResult struct {
+	Success bool
+	// Outputs are the unpacked return values, set when the call had an ABI and succeeded
+	Outputs    []interface{}
+	ReturnData []byte
+	Err        error
+}
+
+// MulticallBackend is the part of the EVM RPC used to reach Multicall3
+type MulticallBackend interface {
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// Multicaller batches contract reads into Multicall3 aggregate3 calls
+// Without a Multicall3 deployment it falls back to JSON-RPC batch requests
+type Multicaller struct {
+	ctx       context.Context
+	backend   MulticallBackend
+	rpc       RPCBatcher
+	address   common.Address
+	block     *big.Int
+	batchSize int
+	support   *multicallSupport
+}
+
+// multicallSupport caches whether Multicall3 is deployed, shared by copies of a Multicaller
+type multicallSupport struct {
+	once      sync.Once
+	available bool
+	err       error
+}
+
+func NewMulticaller(ctx context.Context, backend MulticallBackend, batcher RPCBatcher) (*Multicaller, error) {
+	if backend == nil && batcher == nil {
+		return nil, fmt.Errorf("multicaller needs a backend or an rpc batcher")
+	}
+
+	return &Multicaller{
+		ctx:       ctx,
+		backend:   backend,
+		rpc:       batcher,
+		address:   Multicall3Address,
+		batchSize: DefaultMulticallBatchSize,
+		support:   &multicallSupport{},
+	}, nil
+}
+
+// Multicall returns a Multicaller using the EVM RPC of this client
+func (e *EVMClient) Multicall() (*Multicaller, error) {
+	ethClient := e.GetClient().GetETHClient()
+	return NewMulticaller(e.GetClient().GetContext(), ethClient, ethClient.Client())
+}
+
+// WithAddress returns a new Multicaller using a Multicall3 deployment at a custom address
+func (m *Multicaller) WithAddress(address common.Address) *Multicaller {
+	newMulticaller := *m
+	newMulticaller.address = address
+	newMulticaller.support = &multicallSupport{}
+	return &newMulticaller
+}
+
+// WithBlock returns a new Multicaller reading at a fixed block, nil reads at the latest block
+func (m *Multicaller) WithBlock(block *big.Int) *Multicaller {
+	newMulticaller := *m
+	newMulticaller.block = block
+	return &newMulticaller
+}
+
+// WithBatchSize returns a new Multicaller sending at most size calls per request
+func (m *Multicaller) WithBatchSize(size int) *Multicaller {
+	newMulticaller := *m
+	if size > 0 {
+		newMulticaller.batchSize = size
+	}
+	return &newMulticaller
+}
+
+// UsesMulticall3 reports whether reads go through Multicall3 rather than JSON-RPC batches
+func (m *Multicaller) UsesMulticall3() (bool, error) {
+	m.support.once.Do(func() {
+		if m.backend == nil {
+			return
+		}
+
+		code, err := m.backend.CodeAt(m.ctx, m.address, nil)
+		if err != nil {
+			m.support.err = fmt.Errorf("failed to check multicall3 deployment: %w", err)
+			return
+		}
+		m.support.available = len(code) > 0
+	})
+
+	return m.support.available, m.support.err
+}
+
+// Aggregate performs every call and returns their results in order
+// The error is only set when the batch itself could not be sent; failures of single calls are in CallResult
+func (m *Multicaller) Aggregate(calls []Call) ([]CallResult, error) {
+	data := make([][]byte, len(calls))
+	for i, call := range calls {
+		callData, err := call.pack()
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		data[i] = callData
+	}
+
+	useMulticall, err := m.UsesMulticall3()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []CallResult
+	switch {
+	case useMulticall:
+		results, err = m.aggregate3(calls, data)
+	case m.rpc != nil:
+		results, err = batchEthCall(m.ctx, m.rpc, m.block, calls, data, m.batchSize)
+	default:
+		return nil, fmt.Errorf("multicall3 is not deployed at %s and no rpc batcher is configured", m.address.Hex())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].unpack(calls[i])
+	}
+
+	return results, nil
+}
+
+func (m *Multicaller) aggregate3(calls []Call, data [][]byte) ([]CallResult, error) {
+	multicallABI, err := Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CallResult, 0, len(calls))
+	for start := 0; start < len(calls); start += m.batchSize {
+		end := min(start+m.batchSize, len(calls))
+
+		batch := make([]multicall3Call, 0, end-start)
+		for i := start; i < end; i++ {
+			batch = append(batch, multicall3Call{Target: calls[i].Target, AllowFailure: true, CallData: data[i]})
+		}
+
+		input, err := multicallABI.Pack("aggregate3", batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack aggregate3: %w", err)
+		}
+
+		output, err := m.backend.CallContract(m.ctx, ethereum.CallMsg{To: &m.address, Data: input}, m.block)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate3 of calls %d-%d failed: %w", start, end-1, err)
+		}
+
+		unpacked, err := multicallABI.Unpack("aggregate3", output)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack aggregate3: %w", err)
+		}
+
+		returned := *abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+		if len(returned) != len(batch) {
+			return nil, fmt.Errorf("aggregate3 returned %d results for %d calls", len(returned), len(batch))
+		}
+
+		for i, r := range returned {
+			result := CallResult{Success: r.Success, ReturnData: r.ReturnData}
+			if !r.Success {
+				result.Err = decodeRevert(calls[start+i].ABI, r.ReturnData)
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
+
+// batchEthCall sends one eth_call per call in JSON-RPC batches of batchSize
+func batchEthCall(ctx context.Context, batcher RPCBatcher, block *big.Int, calls []Call, data [][]byte, batchSize int) ([]CallResult, error) {
+	blockArg := "latest"
+	if block != nil {
+		blockArg = hexutil.EncodeBig(block)
+	}
+
+	results := make([]CallResult, 0, len(calls))
+	for start := 0; start < len(calls); start += batchSize {
+		end := min(start+batchSize, len(calls))
+
+		raw := make([]hexutil.Bytes, end-start)
+		batch := make([]rpc.BatchElem, end-start)
+		for i := range batch {
+			batch[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args: []interface{}{
+					map[string]interface{}{
+						"to":   calls[start+i].Target,
+						"data": hexutil.Bytes(data[start+i]),
+					},
+					blockArg,
+				},
+				Result: &raw[i],
+			}
+		}
+
+		if err := batcher.BatchCallContext(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to send batch: %w", err)
+		}
+
+		for i, elem := range batch {
+			if elem.Error != nil {
+				results = append(results, CallResult{Err: decodeRPCError(calls[start+i].ABI, elem.Error)})
+				continue
+			}
+			results = append(results, CallResult{Success: true, ReturnData: raw[i]})
+		}
+	}
+
+	return results, nil
+}
+
+func (c Call) pack() ([]byte, error) {
+	if c.ABI == nil {
+		return c.Data, nil
+	}
+
+	data, err := c.ABI.Pack(c.Method, c.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", c.Method, err)
+	}
+	return data, nil
+}
+
+func (r *CallResult) unpack(call Call) {
+	if !r.Success || call.ABI == nil {
+		return
+	}
+
+	outputs, err := call.ABI.Unpack(call.Method, r.ReturnData)
+	if err != nil {
+		r.Success = false
+		r.Err = fmt.Errorf("failed to unpack %s: %w", call.Method, err)
+		return
+	}
+	r.Outputs = outputs
+}
@@ -0,0 +1,189 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// fakeMulticall3 executes aggregate3 against a fakeERC721
+type fakeMulticall3 struct {
+	token    *fakeERC721
+	deployed bool
+	calls    []int
+}
+
+type fakeAggregateCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type fakeAggregateResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+func (f *fakeMulticall3) CodeAt(_ context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	if f.deployed && contract == evm.Multicall3Address {
+		return []byte{0x60, 0x80}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeMulticall3) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	multicallABI, err := evm.Multicall3MetaData.GetAbi()
+	require.NoError(f.token.t, err)
+	require.Equal(f.token.t, evm.Multicall3Address, *msg.To)
+
+	args, err := multicallABI.Methods["aggregate3"].Inputs.Unpack(msg.Data[4:])
+	require.NoError(f.token.t, err)
+
+	calls := *abi.ConvertType(args[0], new([]fakeAggregateCall)).(*[]fakeAggregateCall)
+	f.calls = append(f.calls, len(calls))
+
+	results := make([]fakeAggregateResult, len(calls))
+	for i, call := range calls {
+		out, err := f.token.call(call.CallData)
+		if err != nil {
+			results[i] = fakeAggregateResult{ReturnData: revertData(f.token.t, err)}
+			continue
+		}
+		results[i] = fakeAggregateResult{Success: true, ReturnData: out}
+	}
+
+	return multicallABI.Methods["aggregate3"].Outputs.Pack(results)
+}
+
+// revertData encodes err as the Error(string) revert payload a contract returns
+func revertData(t *testing.T, err error) []byte {
+	stringType, typeErr := abi.NewType("string", "", nil)
+	require.NoError(t, typeErr)
+
+	reason := strings.TrimPrefix(err.Error(), "execution reverted: ")
+	data, packErr := abi.Arguments{{Type: stringType}}.Pack(reason)
+	require.NoError(t, packErr)
+
+	return append([]byte{0x08, 0xc3, 0x79, 0xa0}, data...)
+}
+
+func ownerOfCalls(token *fakeERC721, tokenIDs ...int64) []evm.Call {
+	calls := make([]evm.Call, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		calls[i] = evm.Call{Target: testContract, ABI: token.abi, Method: "ownerOf", Args: []interface{}{big.NewInt(tokenID)}}
+	}
+	return calls
+}
+
+func TestMulticaller(t *testing.T) {
+	holder := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+
+	token := newFakeERC721(t)
+	for i := int64(1); i <= 5; i++ {
+		token.mint(i, holder)
+	}
+
+	t.Run("Aggregate3 keeps per call failures", func(t *testing.T) {
+		backend := &fakeMulticall3{token: token, deployed: true}
+		multicaller, err := evm.NewMulticaller(context.Background(), backend, token)
+		require.NoError(t, err)
+		multicaller = multicaller.WithBatchSize(2)
+
+		token.batches = nil
+		results, err := multicaller.Aggregate(ownerOfCalls(token, 1, 9, 3))
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		uses, err := multicaller.UsesMulticall3()
+		require.NoError(t, err)
+		assert.True(t, uses)
+		assert.Equal(t, []int{2, 1}, backend.calls)
+		assert.Empty(t, token.batches, "JSON-RPC batches should not be used")
+
+		assert.True(t, results[0].Success)
+		assert.Equal(t, holder, results[0].Outputs[0])
+
+		assert.False(t, results[1].Success)
+		require.Error(t, results[1].Err)
+		assert.Contains(t, results[1].Err.Error(), "ERC721NonexistentToken")
+
+		assert.True(t, results[2].Success)
+	})
+
+	t.Run("Falls back to JSON-RPC batches", func(t *testing.T) {
+		backend := &fakeMulticall3{token: token}
+		multicaller, err := evm.NewMulticaller(context.Background(), backend, token)
+		require.NoError(t, err)
+
+		token.batches = nil
+		results, err := multicaller.Aggregate(ownerOfCalls(token, 2, 7))
+		require.NoError(t, err)
+
+		uses, err := multicaller.UsesMulticall3()
+		require.NoError(t, err)
+		assert.False(t, uses)
+		assert.Empty(t, backend.calls)
+		assert.Equal(t, []int{2}, token.batches)
+
+		assert.True(t, results[0].Success)
+		assert.Equal(t, holder, results[0].Outputs[0])
+		assert.False(t, results[1].Success)
+		assert.Error(t, results[1].Err)
+	})
+
+	t.Run("Raw call data", func(t *testing.T) {
+		data, err := token.abi.Pack("totalSupply")
+		require.NoError(t, err)
+
+		multicaller, err := evm.NewMulticaller(context.Background(), &fakeMulticall3{token: token, deployed: true}, nil)
+		require.NoError(t, err)
+
+		results, err := multicaller.Aggregate([]evm.Call{{Target: testContract, Data: data}})
+		require.NoError(t, err)
+		require.True(t, results[0].Success)
+		assert.Nil(t, results[0].Outputs)
+
+		total, err := token.abi.Unpack("totalSupply", results[0].ReturnData)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(5), total[0])
+	})
+
+	t.Run("Without multicall3 or batcher", func(t *testing.T) {
+		multicaller, err := evm.NewMulticaller(context.Background(), &fakeMulticall3{token: token}, nil)
+		require.NoError(t, err)
+
+		_, err = multicaller.Aggregate(ownerOfCalls(token, 1))
+		assert.ErrorContains(t, err, "not deployed")
+
+		_, err = evm.NewMulticaller(context.Background(), nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("Deployment check errors", func(t *testing.T) {
+		multicaller, err := evm.NewMulticaller(context.Background(), failingCodeBackend{}, token)
+		require.NoError(t, err)
+
+		_, err = multicaller.Aggregate(ownerOfCalls(token, 1))
+		assert.ErrorContains(t, err, "multicall3 deployment")
+	})
+}
+
+type failingCodeBackend struct{}
+
+func (failingCodeBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (failingCodeBackend) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, errors.New("connection refused")
+}
@@ -0,0 +1,87 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SendNative transfers native asix from this account's EVM address to another EVM address
+// The transaction is priced by the fee strategy of the client
+func (e *EVMClient) SendNative(to common.Address, amount *big.Int) (*types.Transaction, error) {
+	goCtx := e.GetClient().GetContext()
+	ethClient := e.GetClient().GetETHClient()
+
+	if to == (common.Address{}) {
+		return nil, fmt.Errorf("destination address cannot be empty")
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	gasLimit, err := e.GasLimit(ethereum.CallMsg{
+		From:  e.GetEVMAddress(),
+		To:    &to,
+		Value: amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := e.GetNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := e.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := e.Fees()
+	if err != nil {
+		return nil, err
+	}
+
+	txData := fees.TxData(chainID, nonce, &to, amount, gasLimit, nil)
+
+	signedTx, err := types.SignNewTx(e.GetPrivateKey(), types.LatestSignerForChainID(chainID), txData)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ethClient.SendTransaction(goCtx, signedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+// SendNativeAndWait transfers native asix and waits for the transaction receipt
+func (e *EVMClient) SendNativeAndWait(to common.Address, amount *big.Int) (*types.Receipt, error) {
+	tx, err := e.SendNative(to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.GetClient().WaitForEVMTransaction(tx.Hash())
+}
+
+// NativeBalance returns the native asix balance of an EVM address
+// A nil block queries the latest block
+func (e *EVMClient) NativeBalance(addr common.Address, block *big.Int) (*big.Int, error) {
+	goCtx := e.GetClient().GetContext()
+	ethClient := e.GetClient().GetETHClient()
+
+	balance, err := ethClient.BalanceAt(goCtx, addr, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance of %s: %w", addr.Hex(), err)
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,350 @@
+package evm
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// PermitDomainVersion is the EIP-712 version certificate contracts are deployed with
+const PermitDomainVersion = "1"
+
+const (
+	permitPrimaryType         = "Permit"
+	permitForAllPrimaryType   = "PermitForAll"
+	transferPermitPrimaryType = "TransferPermit"
+)
+
+var eip712DomainType = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+// Signer signs EIP-712 digests without a client, with a private key or any wallet able to sign a hash
+type Signer interface {
+	Address() common.Address
+	// SignHash returns the 65 byte [R || S || V] signature of hash, V being 0/1 or 27/28
+	SignHash(hash []byte) ([]byte, error)
+}
+
+var _ Signer = (*KeySigner)(nil)
+
+// KeySigner signs with an in-memory private key
+type KeySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{
+		key: key,
+	}
+}
+
+func (k *KeySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(k.key.PublicKey)
+}
+
+func (k *KeySigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, k.key)
+}
+
+// NewPermitDomain returns the domain of a certificate contract named contractName
+func NewPermitDomain(contractName string, chainID *big.Int, contractAddress common.Address) EIP712Domain {
+	return EIP712Domain{
+		Name:              contractName,
+		Version:           PermitDomainVersion,
+		ChainID:           chainID,
+		VerifyingContract: contractAddress,
+	}
+}
+
+func (d EIP712Domain) typedDataDomain() apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              d.Name,
+		Version:           d.Version,
+		ChainId:           (*math.HexOrDecimal256)(d.ChainID),
+		VerifyingContract: d.VerifyingContract.Hex(),
+	}
+}
+
+func (d EIP712Domain) validate() error {
+	if d.ChainID == nil {
+		return fmt.Errorf("domain chain ID is required")
+	}
+
+	if d.VerifyingContract == (common.Address{}) {
+		return fmt.Errorf("domain verifying contract cannot be the zero address")
+	}
+
+	return nil
+}
+
+// Permit approves Spender for one token, signed for permit, transferWithPermit and burnWithPermit
+type Permit struct {
+	Owner    common.Address
+	Spender  common.Address
+	TokenID  *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+func (p Permit) validate() error {
+	if p.TokenID == nil || p.Nonce == nil || p.Deadline == nil {
+		return fmt.Errorf("permit token ID, nonce and deadline are required")
+	}
+	return nil
+}
+
+// TypedData returns the permit as the EIP-712 payload eth_signTypedData_v4 takes
+func (p Permit) TypedData(domain EIP712Domain) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainType,
+			permitPrimaryType: []apitypes.Type{
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "tokenId", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: permitPrimaryType,
+		Domain:      domain.typedDataDomain(),
+		Message: apitypes.TypedDataMessage{
+			"owner":    p.Owner.Hex(),
+			"spender":  p.Spender.Hex(),
+			"tokenId":  p.TokenID.String(),
+			"nonce":    p.Nonce.String(),
+			"deadline": p.Deadline.String(),
+		},
+	}
+}
+
+// Hash returns the EIP-712 digest of the permit
+func (p Permit) Hash(domain EIP712Domain) ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if err := domain.validate(); err != nil {
+		return nil, err
+	}
+	return hashTypedData(p.TypedData(domain))
+}
+
+// PermitForAll approves or revokes Operator for every token of Owner, signed for permitForAll
+type PermitForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+func (p PermitForAll) validate() error {
+	if p.Nonce == nil || p.Deadline == nil {
+		return fmt.Errorf("permit nonce and deadline are required")
+	}
+	return nil
+}
+
+// TypedData returns the permit as the EIP-712 payload eth_signTypedData_v4 takes
+func (p PermitForAll) TypedData(domain EIP712Domain) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainType,
+			permitForAllPrimaryType: []apitypes.Type{
+				{Name: "owner", Type: "address"},
+				{Name: "operator", Type: "address"},
+				{Name: "approved", Type: "bool"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: permitForAllPrimaryType,
+		Domain:      domain.typedDataDomain(),
+		Message: apitypes.TypedDataMessage{
+			"owner":    p.Owner.Hex(),
+			"operator": p.Operator.Hex(),
+			"approved": p.Approved,
+			"nonce":    p.Nonce.String(),
+			"deadline": p.Deadline.String(),
+		},
+	}
+}
+
+// Hash returns the EIP-712 digest of the permit
+func (p PermitForAll) Hash(domain EIP712Domain) ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if err := domain.validate(); err != nil {
+		return nil, err
+	}
+	return hashTypedData(p.TypedData(domain))
+}
+
+// TransferPermit lets anyone move one token of Owner, but only to To, signed for transferWithSignature of LBBCertV2
+type TransferPermit struct {
+	Owner    common.Address
+	To       common.Address
+	TokenID  *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+func (p TransferPermit) validate() error {
+	if p.TokenID == nil || p.Nonce == nil || p.Deadline == nil {
+		return fmt.Errorf("transfer permit token ID, nonce and deadline are required")
+	}
+	if p.To == (common.Address{}) {
+		return fmt.Errorf("transfer permit recipient cannot be the zero address")
+	}
+	return nil
+}
+
+// TypedData returns the permit as the EIP-712 payload eth_signTypedData_v4 takes
+func (p TransferPermit) TypedData(domain EIP712Domain) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainType,
+			transferPermitPrimaryType: []apitypes.Type{
+				{Name: "owner", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "tokenId", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: transferPermitPrimaryType,
+		Domain:      domain.typedDataDomain(),
+		Message: apitypes.TypedDataMessage{
+			"owner":    p.Owner.Hex(),
+			"to":       p.To.Hex(),
+			"tokenId":  p.TokenID.String(),
+			"nonce":    p.Nonce.String(),
+			"deadline": p.Deadline.String(),
+		},
+	}
+}
+
+// Hash returns the EIP-712 digest of the permit
+func (p TransferPermit) Hash(domain EIP712Domain) ([]byte, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if err := domain.validate(); err != nil {
+		return nil, err
+	}
+	return hashTypedData(p.TypedData(domain))
+}
+
+// SignPermitOffline signs a permit from an explicit domain, nonce and deadline, no client or network is needed
+func SignPermitOffline(signer Signer, domain EIP712Domain, permit Permit) (*PermitSignature, error) {
+	if signer.Address() != permit.Owner {
+		return nil, fmt.Errorf("signer %s is not the permit owner %s", signer.Address().Hex(), permit.Owner.Hex())
+	}
+
+	hash, err := permit.Hash(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signPermitHash(signer, hash, permit.Deadline)
+}
+
+// SignPermitForAllOffline signs a permitForAll from an explicit domain, nonce and deadline, no client or network is needed
+func SignPermitForAllOffline(signer Signer, domain EIP712Domain, permit PermitForAll) (*PermitSignature, error) {
+	if signer.Address() != permit.Owner {
+		return nil, fmt.Errorf("signer %s is not the permit owner %s", signer.Address().Hex(), permit.Owner.Hex())
+	}
+
+	hash, err := permit.Hash(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signPermitHash(signer, hash, permit.Deadline)
+}
+
+// SignTransferPermitOffline signs a transferWithSignature permit from an explicit domain, nonce and deadline
+// The nonce is the owner's TransferPermitTypeHash nonce, see EVMClient.SignatureNonce
+func SignTransferPermitOffline(signer Signer, domain EIP712Domain, permit TransferPermit) (*PermitSignature, error) {
+	if signer.Address() != permit.Owner {
+		return nil, fmt.Errorf("signer %s is not the permit owner %s", signer.Address().Hex(), permit.Owner.Hex())
+	}
+
+	hash, err := permit.Hash(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signPermitHash(signer, hash, permit.Deadline)
+}
+
+func signPermitHash(signer Signer, hash []byte, deadline *big.Int) (*PermitSignature, error) {
+	sig, err := signer.SignHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign: %w", err)
+	}
+
+	signature, err := permitSignatureFromBytes(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hardware and remote signers may return a high S, which the contract rejects
+	signature.Normalize()
+	signature.Deadline = deadline
+	return signature, nil
+}
+
+// permitSignatureFromBytes splits a 65 byte signature, V is normalized to 27/28 as the contract expects
+func permitSignatureFromBytes(sig []byte) (*PermitSignature, error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("signature is %d bytes, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	v := sig[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return nil, fmt.Errorf("invalid signature recovery byte %d", sig[64])
+	}
+
+	signature := &PermitSignature{V: v}
+	copy(signature.R[:], sig[:32])
+	copy(signature.S[:], sig[32:64])
+	return signature, nil
+}
+
+// Bytes returns the 65 byte [R || S || V] form wallets produce, V being 27/28
+func (p *PermitSignature) Bytes() []byte {
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], p.R[:])
+	copy(sig[32:64], p.S[:])
+	sig[64] = p.V
+	return sig
+}
+
+func hashTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
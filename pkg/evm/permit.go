@@ -2,19 +2,14 @@ package evm
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
 	"fmt"
 	"math/big"
-	"strings"
 
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/signer/core/apitypes"
-
-	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
 )
 
 // PermitSignature contains the signature components for a permit
@@ -25,6 +20,47 @@ type PermitSignature struct {
 	Deadline *big.Int
 }
 
+// permitSignatureJSON is the wire format of a PermitSignature, r and s as hex
+type permitSignatureJSON struct {
+	V        uint8                 `json:"v"`
+	R        common.Hash           `json:"r"`
+	S        common.Hash           `json:"s"`
+	Deadline *math.HexOrDecimal256 `json:"deadline,omitempty"`
+}
+
+func (p PermitSignature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(permitSignatureJSON{
+		V:        p.V,
+		R:        p.R,
+		S:        p.S,
+		Deadline: (*math.HexOrDecimal256)(p.Deadline),
+	})
+}
+
+// UnmarshalJSON accepts the object MarshalJSON writes or a 65 or 64 byte hex string without a deadline
+func (p *PermitSignature) UnmarshalJSON(data []byte) error {
+	var sig string
+	if err := json.Unmarshal(data, &sig); err == nil {
+		parsed, err := ParsePermitSignatureHex(sig)
+		if err != nil {
+			return err
+		}
+		*p = *parsed
+		return nil
+	}
+
+	var decoded permitSignatureJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	p.V = decoded.V
+	p.R = decoded.R
+	p.S = decoded.S
+	p.Deadline = (*big.Int)(decoded.Deadline)
+	return nil
+}
+
 // EIP712Domain represents the domain separator parameters
 type EIP712Domain struct {
 	Name              string
@@ -34,7 +70,7 @@ type EIP712Domain struct {
 }
 
 // SignPermitForAll creates an EIP-712 signature for permitForAll (gasless setApprovalForAll)
-// This allows a user to sign offline and have anyone broadcast the approval transaction
+// The chain ID and nonce are read over RPC, use SignPermitForAllOffline on a device without a client
 func (e *EVMClient) SignPermitForAll(
 	contractName string,
 	contractAddress common.Address,
@@ -42,86 +78,22 @@ func (e *EVMClient) SignPermitForAll(
 	approved bool,
 	deadline *big.Int,
 ) (*PermitSignature, error) {
-	chainID, err := e.ChainID()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %w", err)
-	}
-
-	// Get current nonce
-	nonce, err := e.GetPermitNonce(contractAddress, e.GetEVMAddress())
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
-	}
-
-	// Create EIP-712 typed data
-	typedData := apitypes.TypedData{
-		Types: apitypes.Types{
-			"EIP712Domain": []apitypes.Type{
-				{Name: "name", Type: "string"},
-				{Name: "version", Type: "string"},
-				{Name: "chainId", Type: "uint256"},
-				{Name: "verifyingContract", Type: "address"},
-			},
-			"PermitForAll": []apitypes.Type{
-				{Name: "owner", Type: "address"},
-				{Name: "operator", Type: "address"},
-				{Name: "approved", Type: "bool"},
-				{Name: "nonce", Type: "uint256"},
-				{Name: "deadline", Type: "uint256"},
-			},
-		},
-		PrimaryType: "PermitForAll",
-		Domain: apitypes.TypedDataDomain{
-			Name:              contractName,
-			Version:           "1",
-			ChainId:           (*math.HexOrDecimal256)(chainID),
-			VerifyingContract: contractAddress.Hex(),
-		},
-		Message: apitypes.TypedDataMessage{
-			"owner":    e.GetEVMAddress().Hex(),
-			"operator": operator.Hex(),
-			"approved": approved,
-			"nonce":    (*math.HexOrDecimal256)(nonce),
-			"deadline": (*math.HexOrDecimal256)(deadline),
-		},
-	}
-
-	// Hash the typed data
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
-	if err != nil {
-		return nil, fmt.Errorf("failed to hash domain: %w", err)
-	}
-
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
-	if err != nil {
-		return nil, fmt.Errorf("failed to hash message: %w", err)
-	}
-
-	// Create the final hash
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	hash := crypto.Keccak256Hash(rawData)
-
-	// Sign the hash
-	signature, err := crypto.Sign(hash.Bytes(), e.GetPrivateKey())
+	domain, nonce, err := e.permitDomainAndNonce(contractName, contractAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign: %w", err)
+		return nil, err
 	}
 
-	// Extract v, r, s
-	var r, s [32]byte
-	copy(r[:], signature[:32])
-	copy(s[:], signature[32:64])
-	v := signature[64] + 27 // Ethereum uses 27/28 instead of 0/1
-
-	return &PermitSignature{
-		V:        v,
-		R:        r,
-		S:        s,
+	return SignPermitForAllOffline(NewKeySigner(e.GetPrivateKey()), domain, PermitForAll{
+		Owner:    e.GetEVMAddress(),
+		Operator: operator,
+		Approved: approved,
+		Nonce:    nonce,
 		Deadline: deadline,
-	}, nil
+	})
 }
 
 // SignPermit creates an EIP-712 signature for permit (gasless approval for specific token)
+// The chain ID and nonce are read over RPC, use SignPermitOffline on a device without a client
 func (e *EVMClient) SignPermit(
 	contractName string,
 	contractAddress common.Address,
@@ -129,102 +101,89 @@ func (e *EVMClient) SignPermit(
 	tokenID *big.Int,
 	deadline *big.Int,
 ) (*PermitSignature, error) {
+	domain, nonce, err := e.permitDomainAndNonce(contractName, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return SignPermitOffline(NewKeySigner(e.GetPrivateKey()), domain, Permit{
+		Owner:    e.GetEVMAddress(),
+		Spender:  spender,
+		TokenID:  tokenID,
+		Nonce:    nonce,
+		Deadline: deadline,
+	})
+}
+
+func (e *EVMClient) permitDomainAndNonce(contractName string, contractAddress common.Address) (EIP712Domain, *big.Int, error) {
 	chainID, err := e.ChainID()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		return EIP712Domain{}, nil, fmt.Errorf("failed to get chain ID: %w", err)
 	}
 
 	nonce, err := e.GetPermitNonce(contractAddress, e.GetEVMAddress())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
+		return EIP712Domain{}, nil, fmt.Errorf("failed to get nonce: %w", err)
 	}
 
-	typedData := apitypes.TypedData{
-		Types: apitypes.Types{
-			"EIP712Domain": []apitypes.Type{
-				{Name: "name", Type: "string"},
-				{Name: "version", Type: "string"},
-				{Name: "chainId", Type: "uint256"},
-				{Name: "verifyingContract", Type: "address"},
-			},
-			"Permit": []apitypes.Type{
-				{Name: "owner", Type: "address"},
-				{Name: "spender", Type: "address"},
-				{Name: "tokenId", Type: "uint256"},
-				{Name: "nonce", Type: "uint256"},
-				{Name: "deadline", Type: "uint256"},
-			},
-		},
-		PrimaryType: "Permit",
-		Domain: apitypes.TypedDataDomain{
-			Name:              contractName,
-			Version:           "1",
-			ChainId:           (*math.HexOrDecimal256)(chainID),
-			VerifyingContract: contractAddress.Hex(),
-		},
-		Message: apitypes.TypedDataMessage{
-			"owner":    e.GetEVMAddress().Hex(),
-			"spender":  spender.Hex(),
-			"tokenId":  (*math.HexOrDecimal256)(tokenID),
-			"nonce":    (*math.HexOrDecimal256)(nonce),
-			"deadline": (*math.HexOrDecimal256)(deadline),
-		},
-	}
+	return NewPermitDomain(contractName, chainID, contractAddress), nonce, nil
+}
 
-	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+// PermitDomain reads the EIP-712 domain a certificate contract verifies permits against
+func (e *EVMClient) PermitDomain(contractAddress common.Address) (*EIP712Domain, error) {
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to hash domain: %w", err)
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	domain, err := contract.Eip712Domain(e.CallOpts())
 	if err != nil {
-		return nil, fmt.Errorf("failed to hash message: %w", err)
+		return nil, fmt.Errorf("failed to call eip712Domain: %w", err)
 	}
 
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-	hash := crypto.Keccak256Hash(rawData)
-
-	signature, err := crypto.Sign(hash.Bytes(), e.GetPrivateKey())
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign: %w", err)
-	}
+	return &EIP712Domain{
+		Name:              domain.Name,
+		Version:           domain.Version,
+		ChainID:           domain.ChainId,
+		VerifyingContract: domain.VerifyingContract,
+	}, nil
+}
 
-	var r, s [32]byte
-	copy(r[:], signature[:32])
-	copy(s[:], signature[32:64])
-	v := signature[64] + 27
+// PermitHash returns the digest signed for permit, transferWithPermit and burnWithPermit
+// The contract approves msg.sender, so spender is the address that broadcasts the permit
+func (d EIP712Domain) PermitHash(owner, spender common.Address, tokenID, nonce, deadline *big.Int) ([]byte, error) {
+	return Permit{
+		Owner:    owner,
+		Spender:  spender,
+		TokenID:  tokenID,
+		Nonce:    nonce,
+		Deadline: deadline,
+	}.Hash(d)
+}
 
-	return &PermitSignature{
-		V:        v,
-		R:        r,
-		S:        s,
+// PermitForAllHash returns the digest signed for permitForAll
+func (d EIP712Domain) PermitForAllHash(owner, operator common.Address, approved bool, nonce, deadline *big.Int) ([]byte, error) {
+	return PermitForAll{
+		Owner:    owner,
+		Operator: operator,
+		Approved: approved,
+		Nonce:    nonce,
 		Deadline: deadline,
-	}, nil
+	}.Hash(d)
 }
 
 // GetPermitNonce gets the current nonce for an address from the contract
 func (e *EVMClient) GetPermitNonce(contractAddress common.Address, owner common.Address) (*big.Int, error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
-	// Call the nonces(address) function
-	data := crypto.Keccak256([]byte("nonces(address)"))[:4]
-	paddedAddress := common.LeftPadBytes(owner.Bytes(), 32)
-	data = append(data, paddedAddress...)
-
-	msg := map[string]interface{}{
-		"to":   contractAddress.Hex(),
-		"data": "0x" + common.Bytes2Hex(data),
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	var result string
-	err := ethClient.Client().CallContext(goCtx, &result, "eth_call", msg, "latest")
+	nonce, err := contract.Nonces(e.CallOpts(), owner)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call nonces: %w", err)
 	}
 
-	nonce := new(big.Int)
-	nonce.SetString(result[2:], 16) // Remove "0x" prefix
 	return nonce, nil
 }
 
@@ -280,67 +239,23 @@ func (e *EVMClient) ExecutePermitForAll(
 	approved bool,
 	signature *PermitSignature,
 ) (*types.Transaction, error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
-	// Get contract ABI
-	stringABI, err := assets.GetContractABIString()
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get contract ABI: %w", err)
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
+	// Signed with broadcaster's key (they pay for gas)
+	opts, err := e.TransactOpts()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+		return nil, err
 	}
 
-	// Pack the permitForAll function call
-	data, err := contractABI.Pack("permitForAll", owner, operator, approved, signature.Deadline, signature.V, signature.R, signature.S)
+	tx, err := contract.PermitForAll(opts, owner, operator, approved, signature.Deadline, signature.V, signature.R, signature.S)
 	if err != nil {
-		return nil, fmt.Errorf("failed to pack permitForAll: %w", err)
+		return nil, fmt.Errorf("failed to send permitForAll: %w", err)
 	}
 
-	// Estimate gas
-	gasLimit, err := e.GasLimit(ethereum.CallMsg{
-		From: e.GetEVMAddress(),
-		To:   &contractAddress,
-		Data: data,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to estimate gas: %w", err)
-	}
-
-	nonce, err := e.GetNonce()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
-	}
-
-	gasPrice, err := e.GasPrice()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %w", err)
-	}
-
-	// Create transaction
-	tx := types.NewTransaction(nonce, contractAddress, big.NewInt(0), gasLimit, gasPrice, data)
-
-	chainID, err := e.ChainID()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %w", err)
-	}
-
-	// Sign with broadcaster's key (they pay for gas)
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), e.GetPrivateKey())
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
-	}
-
-	// Broadcast
-	err = ethClient.SendTransaction(goCtx, signedTx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to send transaction: %w", err)
-	}
-
-	return signedTx, nil
+	return tx, nil
 }
 
 // TransferWithPermit transfers an NFT using a permit signature (completely gasless for owner)
@@ -352,67 +267,26 @@ func (e *EVMClient) TransferWithPermit(
 	tokenID *big.Int,
 	signature *PermitSignature,
 ) (*types.Transaction, error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
-	// Get contract ABI
-	stringABI, err := assets.GetContractABIString()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get contract ABI: %w", err)
-	}
-
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse ABI: %w", err)
-	}
-
-	// Pack the transferWithPermit function call
-	data, err := contractABI.Pack("transferWithPermit", from, to, tokenID, signature.Deadline, signature.V, signature.R, signature.S)
-	if err != nil {
-		return nil, fmt.Errorf("failed to pack transferWithPermit: %w", err)
-	}
-
-	// Estimate gas
-	gasLimit, err := e.GasLimit(ethereum.CallMsg{
-		From: e.GetEVMAddress(),
-		To:   &contractAddress,
-		Data: data,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to estimate gas: %w", err)
-	}
-
-	nonce, err := e.GetNonce()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	if err := e.requireTransferable(contractAddress, tokenID); err != nil {
+		return nil, err
 	}
 
-	gasPrice, err := e.GasPrice()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %w", err)
-	}
-
-	// Create transaction
-	tx := types.NewTransaction(nonce, contractAddress, big.NewInt(0), gasLimit, gasPrice, data)
-
-	chainID, err := e.ChainID()
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	// Sign with broadcaster's key (they pay for gas)
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), e.GetPrivateKey())
+	opts, err := e.TransactOpts()
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		return nil, err
 	}
 
-	// Broadcast
-	err = ethClient.SendTransaction(goCtx, signedTx)
+	tx, err := contract.TransferWithPermit(opts, from, to, tokenID, signature.Deadline, signature.V, signature.R, signature.S)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send transaction: %w", err)
+		return nil, fmt.Errorf("failed to send transferWithPermit: %w", err)
 	}
 
-	return signedTx, nil
+	return tx, nil
 }
 
 func (e *EVMClient) BurnWithPermit(
@@ -421,65 +295,20 @@ func (e *EVMClient) BurnWithPermit(
 	tokenID *big.Int,
 	signature *PermitSignature,
 ) (*types.Transaction, error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
-	// Get contract ABI
-	stringABI, err := assets.GetContractABIString()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get contract ABI: %w", err)
-	}
-
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse ABI: %w", err)
-	}
-
-	// Pack the transferWithPermit function call
-	data, err := contractABI.Pack("burnWithPermit", from, tokenID, signature.Deadline, signature.V, signature.R, signature.S)
-	if err != nil {
-		return nil, fmt.Errorf("failed to pack transferWithPermit: %w", err)
-	}
-
-	// Estimate gas
-	gasLimit, err := e.GasLimit(ethereum.CallMsg{
-		From: e.GetEVMAddress(),
-		To:   &contractAddress,
-		Data: data,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to estimate gas: %w", err)
-	}
-
-	nonce, err := e.GetNonce()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %w", err)
-	}
-
-	gasPrice, err := e.GasPrice()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get gas price: %w", err)
-	}
-
-	// Create transaction
-	tx := types.NewTransaction(nonce, contractAddress, big.NewInt(0), gasLimit, gasPrice, data)
-
-	chainID, err := e.ChainID()
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	// Sign with broadcaster's key (they pay for gas)
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(chainID), e.GetPrivateKey())
+	opts, err := e.TransactOpts()
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		return nil, err
 	}
 
-	// Broadcast
-	err = ethClient.SendTransaction(goCtx, signedTx)
+	tx, err := contract.BurnWithPermit(opts, from, tokenID, signature.Deadline, signature.V, signature.R, signature.S)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send transaction: %w", err)
+		return nil, fmt.Errorf("failed to send burnWithPermit: %w", err)
 	}
 
-	return signedTx, nil
+	return tx, nil
 }
@@ -0,0 +1,231 @@
+package evm_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// permitDigest hashes a Permit the way the contract does with abi.encode and _hashTypedDataV4
+func permitDigest(domain evm.EIP712Domain, owner, spender common.Address, tokenID, nonce, deadline *big.Int) []byte {
+	word := func(value *big.Int) []byte {
+		return math.U256Bytes(new(big.Int).Set(value))
+	}
+
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(domain.Name)),
+		crypto.Keccak256([]byte(domain.Version)),
+		word(domain.ChainID),
+		common.LeftPadBytes(domain.VerifyingContract.Bytes(), 32),
+	)
+
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 tokenId,uint256 nonce,uint256 deadline)")),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		word(tokenID),
+		word(nonce),
+		word(deadline),
+	)
+
+	return crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+func TestPermitHash(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	domain := evm.EIP712Domain{
+		Name:              "MyNFTCert",
+		Version:           "1",
+		ChainID:           big.NewInt(150),
+		VerifyingContract: common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314"),
+	}
+	spender := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	deadline := big.NewInt(1_900_000_000)
+
+	hash, err := domain.PermitHash(owner, spender, big.NewInt(7), big.NewInt(3), deadline)
+	require.NoError(t, err)
+	assert.Equal(t, permitDigest(domain, owner, spender, big.NewInt(7), big.NewInt(3), deadline), hash)
+
+	signature, err := evm.SignPermitMessage(key, hash)
+	require.NoError(t, err)
+
+	valid, err := evm.VerifyPermitSignature(owner, signature, hash)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	forAll, err := domain.PermitForAllHash(owner, spender, true, big.NewInt(3), deadline)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, forAll)
+
+	t.Run("JSON", func(t *testing.T) {
+		signature.Deadline = deadline
+
+		data, err := json.Marshal(signature)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), common.Hash(signature.R).Hex())
+
+		var decoded evm.PermitSignature
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, *signature, decoded)
+
+		// Deadlines may be sent as decimal strings
+		r, s := common.BigToHash(big.NewInt(1)).Hex(), common.BigToHash(big.NewInt(2)).Hex()
+		require.NoError(t, json.Unmarshal([]byte(`{"v":27,"r":"`+r+`","s":"`+s+`","deadline":"1900000000"}`), &decoded))
+		assert.Equal(t, deadline, decoded.Deadline)
+	})
+}
+
+func TestSignPermitOffline(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := evm.NewKeySigner(key)
+
+	domain := evm.NewPermitDomain("MyNFTCert", big.NewInt(150), common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314"))
+	permit := evm.Permit{
+		Owner:    signer.Address(),
+		Spender:  common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"),
+		TokenID:  big.NewInt(7),
+		Nonce:    big.NewInt(3),
+		Deadline: big.NewInt(1_900_000_000),
+	}
+
+	signature, err := evm.SignPermitOffline(signer, domain, permit)
+	require.NoError(t, err)
+	assert.Equal(t, permit.Deadline, signature.Deadline)
+
+	valid, err := evm.VerifyPermitSignature(permit.Owner, signature, permitDigest(domain, permit.Owner, permit.Spender, permit.TokenID, permit.Nonce, permit.Deadline))
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	t.Run("Signer is not the owner", func(t *testing.T) {
+		other := permit
+		other.Owner = permit.Spender
+
+		_, err := evm.SignPermitOffline(signer, domain, other)
+		assert.ErrorContains(t, err, "not the permit owner")
+	})
+
+	t.Run("Missing nonce", func(t *testing.T) {
+		missing := permit
+		missing.Nonce = nil
+
+		_, err := evm.SignPermitOffline(signer, domain, missing)
+		assert.Error(t, err)
+	})
+
+	t.Run("Permit for all", func(t *testing.T) {
+		forAll := evm.PermitForAll{Owner: signer.Address(), Operator: permit.Spender, Approved: true, Nonce: big.NewInt(0), Deadline: permit.Deadline}
+
+		signature, err := evm.SignPermitForAllOffline(signer, domain, forAll)
+		require.NoError(t, err)
+
+		hash, err := domain.PermitForAllHash(forAll.Owner, forAll.Operator, forAll.Approved, forAll.Nonce, forAll.Deadline)
+		require.NoError(t, err)
+
+		valid, err := evm.VerifyPermitSignature(forAll.Owner, signature, hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
+
+func TestPermitEnvelope(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := evm.NewKeySigner(key)
+
+	domain := evm.NewPermitDomain("MyNFTCert", big.NewInt(150), common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314"))
+	permit := evm.Permit{
+		Owner:    signer.Address(),
+		Spender:  common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"),
+		TokenID:  big.NewInt(7),
+		Nonce:    big.NewInt(3),
+		Deadline: big.NewInt(1_900_000_000),
+	}
+
+	envelope, err := evm.NewPermitEnvelope(domain, permit)
+	require.NoError(t, err)
+
+	// The envelope goes to a wallet as JSON and comes back signed
+	data, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	var received evm.PermitEnvelope
+	require.NoError(t, json.Unmarshal(data, &received))
+	require.NoError(t, received.Sign(signer))
+	require.NoError(t, received.Verify())
+
+	decoded, err := received.Permit()
+	require.NoError(t, err)
+	assert.Equal(t, permit, *decoded)
+
+	// Signing the envelope and signing offline give the same signature
+	signature, err := received.PermitSignature()
+	require.NoError(t, err)
+	offline, err := evm.SignPermitOffline(signer, domain, permit)
+	require.NoError(t, err)
+	assert.Equal(t, offline, signature)
+
+	t.Run("Wallet JSON", func(t *testing.T) {
+		// A wallet may send uint256 values as numbers and the chain ID as hex
+		walletJSON := `{
+			"typedData": {
+				"types": {
+					"EIP712Domain": [
+						{"name": "name", "type": "string"},
+						{"name": "version", "type": "string"},
+						{"name": "chainId", "type": "uint256"},
+						{"name": "verifyingContract", "type": "address"}
+					],
+					"Permit": [
+						{"name": "owner", "type": "address"},
+						{"name": "spender", "type": "address"},
+						{"name": "tokenId", "type": "uint256"},
+						{"name": "nonce", "type": "uint256"},
+						{"name": "deadline", "type": "uint256"}
+					]
+				},
+				"primaryType": "Permit",
+				"domain": {"name": "MyNFTCert", "version": "1", "chainId": "0x96", "verifyingContract": "0x0102030405060708090a0b0c0d0e0f1011121314"},
+				"message": {"owner": "` + permit.Owner.Hex() + `", "spender": "` + permit.Spender.Hex() + `", "tokenId": 7, "nonce": 3, "deadline": 1900000000}
+			},
+			"signature": "` + hexutil.Encode(offline.Bytes()) + `"
+		}`
+
+		var wallet evm.PermitEnvelope
+		require.NoError(t, json.Unmarshal([]byte(walletJSON), &wallet))
+		assert.NoError(t, wallet.Verify())
+	})
+
+	t.Run("Tampered message", func(t *testing.T) {
+		var tampered evm.PermitEnvelope
+		require.NoError(t, json.Unmarshal(data, &tampered))
+		tampered.Signature = received.Signature
+		tampered.TypedData.Message["tokenId"] = "8"
+
+		assert.ErrorContains(t, tampered.Verify(), "not signed by the permit owner")
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		assert.ErrorContains(t, envelope.Verify(), "not signed")
+	})
+
+	t.Run("Signer is not the owner", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		assert.Error(t, envelope.Sign(evm.NewKeySigner(otherKey)))
+	})
+}
@@ -3,91 +3,209 @@ package evm
 import (
 	"fmt"
 	"math/big"
-	"strings"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
 )
 
 func (e *EVMClient) TokenOwner(contractAddress common.Address, tokenID uint64) common.Address {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
-
 	var currentOwner common.Address
 
-	stringABI, err := assets.GetContractABIString()
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
+		fmt.Printf("failed to bind contract: %v\n", err)
 		return currentOwner
 	}
 
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
+	currentOwner, err = contract.OwnerOf(e.CallOpts(), new(big.Int).SetUint64(tokenID))
 	if err != nil {
-		return currentOwner
+		fmt.Printf("failed to call contract: %v\n", err)
+		return common.Address{}
 	}
 
-	// Pack the function call
-	data, err := contractABI.Pack("ownerOf", big.NewInt(int64(tokenID)))
+	return currentOwner
+}
+
+func (e *EVMClient) IsMinted(contractAddress common.Address, tokenID uint64) (bool, error) {
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		fmt.Printf("failed to pack data: %v\n", err)
-		return currentOwner
+		return false, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	result, err := ethClient.CallContract(goCtx, ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
-	}, nil)
+	// ownerOf reverts for tokens that do not exist
+	owner, err := contract.OwnerOf(e.CallOpts(), new(big.Int).SetUint64(tokenID))
 	if err != nil {
-		fmt.Printf("failed to call contract: %v\n", err)
-		return currentOwner
+		return false, nil
 	}
 
-	var addressOutpu common.Address
+	return owner != common.Address{}, nil
+}
 
-	err = contractABI.UnpackIntoInterface(&addressOutpu, "ownerOf", result)
+// TokenOwners returns the owner of every token in one batched read
+// Tokens that do not exist have the zero address as owner
+func (e *EVMClient) TokenOwners(contractAddress common.Address, tokenIDs []uint64) ([]common.Address, error) {
+	results, err := e.ownerOfBatch(contractAddress, tokenIDs)
 	if err != nil {
-		fmt.Printf("failed to call contract: %v\n", err)
-		return currentOwner
+		return nil, err
+	}
+
+	owners := make([]common.Address, len(results))
+	for i, result := range results {
+		if result.Success {
+			owners[i] = *abi.ConvertType(result.Outputs[0], new(common.Address)).(*common.Address)
+		}
 	}
 
-	return addressOutpu
+	return owners, nil
 }
 
-func (e *EVMClient) IsMinted(contractAddress common.Address, tokenID uint64) (bool, error) {
-	goCtx := e.GetClient().GetContext()
-	ethClient := e.GetClient().GetETHClient()
+// AreMinted reports for every token whether it is minted, in one batched read
+func (e *EVMClient) AreMinted(contractAddress common.Address, tokenIDs []uint64) ([]bool, error) {
+	owners, err := e.TokenOwners(contractAddress, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	minted := make([]bool, len(owners))
+	for i, owner := range owners {
+		minted[i] = owner != common.Address{}
+	}
+
+	return minted, nil
+}
 
-	stringABI, err := assets.GetContractABIString()
+func (e *EVMClient) ownerOfBatch(contractAddress common.Address, tokenIDs []uint64) ([]CallResult, error) {
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
 	if err != nil {
-		return false, fmt.Errorf("failed to get ABI: %w", err)
+		return nil, err
 	}
 
-	contractABI, err := abi.JSON(strings.NewReader(stringABI))
+	multicaller, err := e.Multicall()
 	if err != nil {
-		return false, fmt.Errorf("failed to parse ABI: %w", err)
+		return nil, err
+	}
+
+	calls := make([]Call, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		calls[i] = Call{
+			Target: contractAddress,
+			ABI:    contractABI,
+			Method: "ownerOf",
+			Args:   []interface{}{new(big.Int).SetUint64(tokenID)},
+		}
 	}
 
-	// 1. Pack the "ownerOf" function call
-	data, err := contractABI.Pack("ownerOf", big.NewInt(int64(tokenID)))
+	return multicaller.Aggregate(calls)
+}
+
+// TokenURI returns the metadata URI of a minted token
+func (e *EVMClient) TokenURI(contractAddress common.Address, tokenID uint64) (string, error) {
+	contract, err := e.LBBCert(contractAddress)
 	if err != nil {
-		return false, fmt.Errorf("failed to pack ownerOf: %w", err)
+		return "", fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	result, err := ethClient.CallContract(goCtx, ethereum.CallMsg{
-		To:   &contractAddress,
-		Data: data,
-	}, nil)
+	return contract.TokenURI(e.CallOpts(), new(big.Int).SetUint64(tokenID))
+}
+
+// NextTokenID returns the token ID the next MintCertNFT on an auto-incrementing contract will use
+func (e *EVMClient) NextTokenID(contractAddress common.Address) (*big.Int, error) {
+	contract, err := e.CertAutoID(contractAddress)
 	if err != nil {
-		return false, nil
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
 	}
 
-	unpacked, err := contractABI.Unpack("ownerOf", result)
+	return contract.NextTokenId(e.CallOpts())
+}
+
+// BalanceOf returns the number of certificates held by owner
+func (e *EVMClient) BalanceOf(contractAddress common.Address, owner common.Address) (*big.Int, error) {
+	reader, err := e.Enumerable(contractAddress)
 	if err != nil {
-		return false, fmt.Errorf("failed to unpack result: %w", err)
+		return nil, err
 	}
 
-	owner := *abi.ConvertType(unpacked[0], new(common.Address)).(*common.Address)
-	return owner != common.Address{}, nil
+	return reader.BalanceOf(owner)
+}
+
+// TotalSupply returns the number of certificates in existence
+func (e *EVMClient) TotalSupply(contractAddress common.Address) (*big.Int, error) {
+	reader, err := e.Enumerable(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.TotalSupply()
+}
+
+// TokenByIndex returns the certificate at index among all certificates of the contract
+func (e *EVMClient) TokenByIndex(contractAddress common.Address, index uint64) (*big.Int, error) {
+	reader, err := e.Enumerable(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.TokenByIndex(new(big.Int).SetUint64(index))
+}
+
+// TokensOfOwner returns every certificate held by owner using batched reads
+func (e *EVMClient) TokensOfOwner(contractAddress common.Address, owner common.Address) ([]*big.Int, error) {
+	reader, err := e.Enumerable(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.TokensOfOwner(owner)
+}
+
+// TokenSnapshot returns an iterator over every certificate of the contract with its owner and URI
+func (e *EVMClient) TokenSnapshot(contractAddress common.Address, pageSize int) (*TokenSnapshotIterator, error) {
+	reader, err := e.Enumerable(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.Snapshot(pageSize), nil
+}
+
+// BurnedTokens returns the tokens burned on a certificate contract since fromBlock, in burn order
+// Logs are read DefaultIndexerBatchSize blocks at a time
+func (e *EVMClient) BurnedTokens(contractAddress common.Address, fromBlock uint64) ([]*big.Int, error) {
+	contract, err := e.LBBCert(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	head, err := e.GetClient().GetETHClient().BlockNumber(e.GetClient().GetContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block: %w", err)
+	}
+
+	var burned []*big.Int
+	for start := fromBlock; start <= head; start += DefaultIndexerBatchSize {
+		end := min(start+DefaultIndexerBatchSize-1, head)
+
+		it, err := contract.FilterTransfer(&bind.FilterOpts{
+			Start:   start,
+			End:     &end,
+			Context: e.GetClient().GetContext(),
+		}, nil, []common.Address{{}}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter burns in blocks %d-%d: %w", start, end, err)
+		}
+
+		for it.Next() {
+			burned = append(burned, it.Event.TokenId)
+		}
+		if err := it.Error(); err != nil {
+			it.Close()
+			return nil, fmt.Errorf("failed to read burns in blocks %d-%d: %w", start, end, err)
+		}
+		it.Close()
+	}
+
+	return burned, nil
 }
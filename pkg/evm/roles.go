@@ -0,0 +1,190 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/roles"
+)
+
+// Roles of LBBCertRoles, DefaultAdminRole grants and revokes the others
+var (
+	DefaultAdminRole = common.Hash{}
+	MinterRole       = crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+	BurnerRole       = crypto.Keccak256Hash([]byte("BURNER_ROLE"))
+	URISetterRole    = crypto.Keccak256Hash([]byte("URI_SETTER_ROLE"))
+)
+
+// AccessControlInterfaceID is the ERC-165 interface ID of OpenZeppelin IAccessControl
+var AccessControlInterfaceID = [4]byte{0x79, 0x65, 0xdb, 0x0b}
+
+// ErrMissingRole is returned before a transaction the contract would revert for a missing role
+var ErrMissingRole = errors.New("account does not have the role")
+
+// RoleName returns the constant name of a known role or the hex of role
+func RoleName(role common.Hash) string {
+	switch role {
+	case DefaultAdminRole:
+		return "DEFAULT_ADMIN_ROLE"
+	case MinterRole:
+		return "MINTER_ROLE"
+	case BurnerRole:
+		return "BURNER_ROLE"
+	case URISetterRole:
+		return "URI_SETTER_ROLE"
+	}
+	return role.Hex()
+}
+
+// CheckRole fails with ErrMissingRole when account does not hold role on an AccessControl contract
+// accessControlled is false for contracts without AccessControl, which are left to their own checks
+func CheckRole(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, role common.Hash, account common.Address) (accessControlled bool, err error) {
+	accessControlled, err = SupportsInterface(ctx, caller, contractAddress, AccessControlInterfaceID)
+	if err != nil || !accessControlled {
+		return accessControlled, err
+	}
+
+	contract, err := roles.NewLBBCertRolesCaller(contractAddress, caller)
+	if err != nil {
+		return true, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	hasRole, err := contract.HasRole(&bind.CallOpts{Context: ctx}, role, account)
+	if err != nil {
+		return true, fmt.Errorf("failed to call hasRole: %w", err)
+	}
+	if !hasRole {
+		return true, fmt.Errorf("%w: %s does not have %s on %s", ErrMissingRole, account.Hex(), RoleName(role), contractAddress.Hex())
+	}
+
+	return true, nil
+}
+
+// GetRoleMembers lists the accounts holding role on an AccessControlEnumerable contract
+// Members are read one by one with getRoleMemberCount and getRoleMember, the order may change when role is granted or revoked
+func GetRoleMembers(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, role common.Hash) ([]common.Address, error) {
+	contract, err := roles.NewLBBCertRolesCaller(contractAddress, caller)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+
+	count, err := contract.GetRoleMemberCount(opts, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getRoleMemberCount: %w", err)
+	}
+
+	members := make([]common.Address, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		member, err := contract.GetRoleMember(opts, role, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to call getRoleMember %d of %s: %w", i, RoleName(role), err)
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// requireMinter fails early when this account cannot mint on an AccessControl contract
+func (e *EVMClient) requireMinter(contractAddress common.Address) error {
+	_, err := CheckRole(e.GetClient().GetContext(), e.Backend(), contractAddress, MinterRole, e.GetEVMAddress())
+	return err
+}
+
+// DeployRoleCertificateContract deploys LBBCertRoles from a forge artifact of contracts/src/CertRoles.sol
+// This account becomes the default admin and holds every role, the SDK does not bundle the bytecode
+func (e *EVMClient) DeployRoleCertificateContract(artifactPath, contractName, symbol, nftSchemaCode string) (common.Address, *types.Transaction, error) {
+	return e.deployFromArtifact(artifactPath, roles.LBBCertRolesMetaData, contractName, symbol, e.certificateBaseURI(nftSchemaCode), e.GetEVMAddress())
+}
+
+// HasRole reports whether account holds role on a role-based certificate contract
+func (e *EVMClient) HasRole(contractAddress common.Address, role common.Hash, account common.Address) (bool, error) {
+	contract, err := e.LBBCertRoles(contractAddress)
+	if err != nil {
+		return false, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	hasRole, err := contract.HasRole(e.CallOpts(), role, account)
+	if err != nil {
+		return false, fmt.Errorf("failed to call hasRole: %w", err)
+	}
+
+	return hasRole, nil
+}
+
+// RoleMembers lists the accounts holding role on a role-based certificate contract
+func (e *EVMClient) RoleMembers(contractAddress common.Address, role common.Hash) ([]common.Address, error) {
+	return GetRoleMembers(e.GetClient().GetContext(), e.Backend(), contractAddress, role)
+}
+
+// GrantRole gives role to account, this account must hold the admin role of role
+func (e *EVMClient) GrantRole(contractAddress common.Address, role common.Hash, account common.Address) (*types.Transaction, error) {
+	if account == (common.Address{}) {
+		return nil, fmt.Errorf("cannot grant %s to the zero address", RoleName(role))
+	}
+
+	contract, err := e.requireRoleAdmin(contractAddress, role)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.GrantRole(opts, role, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send grantRole: %w", err)
+	}
+
+	return tx, nil
+}
+
+// RevokeRole takes role from account, this account must hold the admin role of role
+func (e *EVMClient) RevokeRole(contractAddress common.Address, role common.Hash, account common.Address) (*types.Transaction, error) {
+	contract, err := e.requireRoleAdmin(contractAddress, role)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.RevokeRole(opts, role, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send revokeRole: %w", err)
+	}
+
+	return tx, nil
+}
+
+// requireRoleAdmin fails early when this account cannot grant or revoke role
+func (e *EVMClient) requireRoleAdmin(contractAddress common.Address, role common.Hash) (*roles.LBBCertRoles, error) {
+	contract, err := e.LBBCertRoles(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	adminRole, err := contract.GetRoleAdmin(e.CallOpts(), role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getRoleAdmin: %w", err)
+	}
+
+	if _, err := CheckRole(e.GetClient().GetContext(), e.Backend(), contractAddress, adminRole, e.GetEVMAddress()); err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
@@ -0,0 +1,157 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/roles"
+)
+
+// fakeRoles answers supportsInterface, hasRole and the role member enumeration of a certificate contract
+type fakeRoles struct {
+	bind.ContractCaller
+	t              *testing.T
+	accessControl  bool
+	members        map[common.Hash][]common.Address
+	hasRoleQueries int
+}
+
+func (f *fakeRoles) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	contractABI, err := roles.LBBCertRolesMetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method, err := contractABI.MethodById(call.Data[:4])
+	require.NoError(f.t, err)
+
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(f.t, err)
+
+	switch method.Name {
+	case "supportsInterface":
+		return method.Outputs.Pack(f.accessControl && args[0].([4]byte) == evm.AccessControlInterfaceID)
+	case "hasRole":
+		f.hasRoleQueries++
+		for _, member := range f.members[common.Hash(args[0].([32]byte))] {
+			if member == args[1].(common.Address) {
+				return method.Outputs.Pack(true)
+			}
+		}
+		return method.Outputs.Pack(false)
+	case "getRoleMemberCount":
+		return method.Outputs.Pack(big.NewInt(int64(len(f.members[common.Hash(args[0].([32]byte))]))))
+	case "getRoleMember":
+		return method.Outputs.Pack(f.members[common.Hash(args[0].([32]byte))][args[1].(*big.Int).Int64()])
+	default:
+		f.t.Fatalf("unexpected call to %s", method.Name)
+		return nil, nil
+	}
+}
+
+func TestCheckRole(t *testing.T) {
+	contractABI, err := roles.LBBCertRolesMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// The IAccessControl interface ID is the XOR of its function selectors
+	var interfaceID [4]byte
+	for _, name := range []string{"hasRole", "getRoleAdmin", "grantRole", "revokeRole", "renounceRole"} {
+		for i, b := range contractABI.Methods[name].ID {
+			interfaceID[i] ^= b
+		}
+	}
+	assert.Equal(t, evm.AccessControlInterfaceID, interfaceID)
+
+	issuer := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	branch := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+	members := map[common.Hash][]common.Address{
+		evm.DefaultAdminRole: {issuer},
+		evm.MinterRole:       {issuer, branch},
+		evm.URISetterRole:    {issuer},
+	}
+
+	tests := []struct {
+		name             string
+		accessControl    bool
+		role             common.Hash
+		account          common.Address
+		accessControlled bool
+		missing          bool
+	}{
+		{name: "Minter", accessControl: true, role: evm.MinterRole, account: branch, accessControlled: true},
+		{name: "Admin", accessControl: true, role: evm.DefaultAdminRole, account: issuer, accessControlled: true},
+		{name: "Missing role", accessControl: true, role: evm.URISetterRole, account: branch, accessControlled: true, missing: true},
+		{name: "Owner based contract", role: evm.MinterRole, account: branch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller := &fakeRoles{t: t, accessControl: tt.accessControl, members: members}
+
+			accessControlled, err := evm.CheckRole(context.Background(), caller, testContract, tt.role, tt.account)
+			assert.Equal(t, tt.accessControlled, accessControlled)
+			if tt.missing {
+				assert.ErrorIs(t, err, evm.ErrMissingRole)
+				assert.ErrorContains(t, err, "URI_SETTER_ROLE")
+				return
+			}
+			require.NoError(t, err)
+
+			// Owner based contracts are left to their own checks
+			if !tt.accessControl {
+				assert.Zero(t, caller.hasRoleQueries)
+			}
+		})
+	}
+
+	t.Run("Role names", func(t *testing.T) {
+		assert.Equal(t, "MINTER_ROLE", evm.RoleName(evm.MinterRole))
+		assert.Equal(t, "BURNER_ROLE", evm.RoleName(evm.BurnerRole))
+		assert.Equal(t, "DEFAULT_ADMIN_ROLE", evm.RoleName(evm.DefaultAdminRole))
+		assert.Equal(t, common.HexToHash("0x01").Hex(), evm.RoleName(common.HexToHash("0x01")))
+	})
+
+	t.Run("Existing mint bindings reach the role contract", func(t *testing.T) {
+		// MintCertificateNFT and MintCertNFT send safeMint of LBBCert and CertAutoID
+		var selectors []string
+		for _, method := range contractABI.Methods {
+			if method.RawName == "safeMint" {
+				selectors = append(selectors, method.Sig)
+			}
+		}
+		assert.ElementsMatch(t, []string{"safeMint(address)", "safeMint(address,uint256)"}, selectors)
+	})
+}
+
+func TestGetRoleMembers(t *testing.T) {
+	issuer := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	branch := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+	caller := &fakeRoles{t: t, accessControl: true, members: map[common.Hash][]common.Address{
+		evm.DefaultAdminRole: {issuer},
+		evm.MinterRole:       {issuer, branch},
+	}}
+
+	tests := []struct {
+		name string
+		role common.Hash
+		want []common.Address
+	}{
+		{name: "Several members", role: evm.MinterRole, want: []common.Address{issuer, branch}},
+		{name: "One member", role: evm.DefaultAdminRole, want: []common.Address{issuer}},
+		{name: "No members", role: evm.BurnerRole, want: []common.Address{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members, err := evm.GetRoleMembers(context.Background(), caller, testContract, tt.role)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, members)
+		})
+	}
+}
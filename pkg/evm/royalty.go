@@ -0,0 +1,161 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/royalty"
+)
+
+// ERC2981InterfaceID is the ERC-165 interface ID of NFT royalties, the selector of royaltyInfo(uint256,uint256)
+var ERC2981InterfaceID = [4]byte{0x2a, 0x55, 0x20, 0x5a}
+
+// RoyaltyFeeDenominator is the fee denominator of LBBCertRoyalty, fees are in basis points of the sale price
+const RoyaltyFeeDenominator = 10_000
+
+// ErrRoyaltyUnsupported is returned for contracts that do not implement ERC-2981
+var ErrRoyaltyUnsupported = errors.New("contract does not implement ERC-2981")
+
+// RoyaltyConfig is the receiver of a royalty and its fee in basis points, 250 is 2.5% of every sale
+type RoyaltyConfig struct {
+	Receiver       common.Address
+	FeeBasisPoints uint16
+}
+
+func (c RoyaltyConfig) validate() error {
+	if c.Receiver == (common.Address{}) {
+		return fmt.Errorf("royalty receiver cannot be the zero address")
+	}
+	if c.FeeBasisPoints > RoyaltyFeeDenominator {
+		return fmt.Errorf("royalty fee of %d basis points is over %d", c.FeeBasisPoints, RoyaltyFeeDenominator)
+	}
+	return nil
+}
+
+// RoyaltyInfo is the royalty owed for one sale of a token
+type RoyaltyInfo struct {
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// GetRoyaltyInfo returns the royalty of tokenID for a sale at salePrice, in the currency of the sale
+// A token without a royalty has the zero address as receiver and a zero amount
+func GetRoyaltyInfo(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, tokenID *big.Int, salePrice *big.Int) (RoyaltyInfo, error) {
+	if salePrice == nil || salePrice.Sign() < 0 {
+		return RoyaltyInfo{}, fmt.Errorf("invalid sale price %v", salePrice)
+	}
+
+	supported, err := SupportsInterface(ctx, caller, contractAddress, ERC2981InterfaceID)
+	if err != nil {
+		return RoyaltyInfo{}, err
+	}
+	if !supported {
+		return RoyaltyInfo{}, fmt.Errorf("%w: %s", ErrRoyaltyUnsupported, contractAddress.Hex())
+	}
+
+	contract, err := royalty.NewLBBCertRoyaltyCaller(contractAddress, caller)
+	if err != nil {
+		return RoyaltyInfo{}, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	info, err := contract.RoyaltyInfo(&bind.CallOpts{Context: ctx}, tokenID, salePrice)
+	if err != nil {
+		return RoyaltyInfo{}, fmt.Errorf("failed to call royaltyInfo: %w", err)
+	}
+
+	return RoyaltyInfo{Receiver: info.Receiver, Amount: info.Amount}, nil
+}
+
+// RoyaltyInfo returns the royalty of tokenID for a sale at salePrice, see GetRoyaltyInfo
+func (e *EVMClient) RoyaltyInfo(contractAddress common.Address, tokenID uint64, salePrice *big.Int) (RoyaltyInfo, error) {
+	return GetRoyaltyInfo(e.GetClient().GetContext(), e.Backend(), contractAddress, new(big.Int).SetUint64(tokenID), salePrice)
+}
+
+// DeployRoyaltyCertificateContract deploys LBBCertRoyalty from a forge artifact of contracts/src/CertRoyalty.sol
+// defaultRoyalty applies to every token, nil deploys without one; the SDK does not bundle the bytecode
+func (e *EVMClient) DeployRoyaltyCertificateContract(artifactPath, contractName, symbol, nftSchemaCode string, defaultRoyalty *RoyaltyConfig) (common.Address, *types.Transaction, error) {
+	var receiver common.Address
+	var fee uint16
+	if defaultRoyalty != nil {
+		if err := defaultRoyalty.validate(); err != nil {
+			return common.Address{}, nil, err
+		}
+		receiver, fee = defaultRoyalty.Receiver, defaultRoyalty.FeeBasisPoints
+	}
+
+	return e.deployFromArtifact(artifactPath, royalty.LBBCertRoyaltyMetaData, contractName, symbol, e.certificateBaseURI(nftSchemaCode), e.GetEVMAddress(), receiver, big.NewInt(int64(fee)))
+}
+
+// SetDefaultRoyalty sets the royalty of every token without a royalty of its own, only the owner may call it
+func (e *EVMClient) SetDefaultRoyalty(contractAddress common.Address, config RoyaltyConfig) (*types.Transaction, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return e.royaltyTransact(contractAddress, "setDefaultRoyalty", func(contract *royalty.LBBCertRoyalty, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.SetDefaultRoyalty(opts, config.Receiver, big.NewInt(int64(config.FeeBasisPoints)))
+	})
+}
+
+// ClearDefaultRoyalty removes the default royalty, tokens with a royalty of their own keep it
+func (e *EVMClient) ClearDefaultRoyalty(contractAddress common.Address) (*types.Transaction, error) {
+	return e.royaltyTransact(contractAddress, "deleteDefaultRoyalty", func(contract *royalty.LBBCertRoyalty, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.DeleteDefaultRoyalty(opts)
+	})
+}
+
+// SetTokenRoyalty overrides the default royalty for tokenID, which does not need to be minted yet
+func (e *EVMClient) SetTokenRoyalty(contractAddress common.Address, tokenID uint64, config RoyaltyConfig) (*types.Transaction, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	return e.royaltyTransact(contractAddress, "setTokenRoyalty", func(contract *royalty.LBBCertRoyalty, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.SetTokenRoyalty(opts, new(big.Int).SetUint64(tokenID), config.Receiver, big.NewInt(int64(config.FeeBasisPoints)))
+	})
+}
+
+// ClearTokenRoyalty makes tokenID fall back to the default royalty
+func (e *EVMClient) ClearTokenRoyalty(contractAddress common.Address, tokenID uint64) (*types.Transaction, error) {
+	return e.royaltyTransact(contractAddress, "resetTokenRoyalty", func(contract *royalty.LBBCertRoyalty, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.ResetTokenRoyalty(opts, new(big.Int).SetUint64(tokenID))
+	})
+}
+
+// royaltyTransact checks that the contract has royalties and that this account owns it before sending
+func (e *EVMClient) royaltyTransact(contractAddress common.Address, method string, send func(*royalty.LBBCertRoyalty, *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	supported, err := SupportsInterface(e.GetClient().GetContext(), e.Backend(), contractAddress, ERC2981InterfaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, fmt.Errorf("%w: %s", ErrRoyaltyUnsupported, contractAddress.Hex())
+	}
+
+	if err := e.requireContractOwner(contractAddress); err != nil {
+		return nil, err
+	}
+
+	contract, err := e.LBBCertRoyalty(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := send(contract, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s: %w", method, err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,120 @@
+package evm_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/royalty"
+)
+
+// fakeRoyalty answers supportsInterface and royaltyInfo the way OpenZeppelin ERC2981 does
+type fakeRoyalty struct {
+	bind.ContractCaller
+	t       *testing.T
+	erc2981 bool
+	// defaultRoyalty and tokenRoyalties are fees in basis points by receiver
+	defaultRoyalty *evm.RoyaltyConfig
+	tokenRoyalties map[int64]evm.RoyaltyConfig
+}
+
+func (f *fakeRoyalty) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	contractABI, err := royalty.LBBCertRoyaltyMetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method, err := contractABI.MethodById(call.Data[:4])
+	require.NoError(f.t, err)
+
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(f.t, err)
+
+	switch method.Name {
+	case "supportsInterface":
+		return method.Outputs.Pack(f.erc2981 && args[0].([4]byte) == evm.ERC2981InterfaceID)
+	case "royaltyInfo":
+		config, ok := f.tokenRoyalties[args[0].(*big.Int).Int64()]
+		if !ok && f.defaultRoyalty != nil {
+			config, ok = *f.defaultRoyalty, true
+		}
+		if !ok {
+			return method.Outputs.Pack(common.Address{}, new(big.Int))
+		}
+
+		amount := new(big.Int).Mul(args[1].(*big.Int), big.NewInt(int64(config.FeeBasisPoints)))
+		return method.Outputs.Pack(config.Receiver, amount.Div(amount, big.NewInt(evm.RoyaltyFeeDenominator)))
+	default:
+		f.t.Fatalf("unexpected call to %s", method.Name)
+		return nil, nil
+	}
+}
+
+func TestGetRoyaltyInfo(t *testing.T) {
+	contractABI, err := royalty.LBBCertRoyaltyMetaData.GetAbi()
+	require.NoError(t, err)
+	assert.Equal(t, contractABI.Methods["royaltyInfo"].ID, evm.ERC2981InterfaceID[:])
+
+	issuer := common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	artist := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+	salePrice, _ := new(big.Int).SetString("2000000000000000000", 10)
+
+	tests := []struct {
+		name     string
+		contract *fakeRoyalty
+		tokenID  int64
+		want     evm.RoyaltyInfo
+		err      error
+	}{
+		{
+			name:     "Default royalty",
+			contract: &fakeRoyalty{erc2981: true, defaultRoyalty: &evm.RoyaltyConfig{Receiver: issuer, FeeBasisPoints: 250}},
+			tokenID:  1,
+			want:     evm.RoyaltyInfo{Receiver: issuer, Amount: big.NewInt(50_000_000_000_000_000)},
+		},
+		{
+			name:     "Token royalty overrides the default",
+			contract: &fakeRoyalty{erc2981: true, defaultRoyalty: &evm.RoyaltyConfig{Receiver: issuer, FeeBasisPoints: 250}},
+			tokenID:  7,
+			want:     evm.RoyaltyInfo{Receiver: artist, Amount: big.NewInt(200_000_000_000_000_000)},
+		},
+		{
+			name:     "No royalty",
+			contract: &fakeRoyalty{erc2981: true},
+			tokenID:  1,
+			want:     evm.RoyaltyInfo{Amount: new(big.Int)},
+		},
+		{
+			name:     "Contract without ERC-2981",
+			contract: &fakeRoyalty{},
+			tokenID:  1,
+			err:      evm.ErrRoyaltyUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.contract.t = t
+			tt.contract.tokenRoyalties = map[int64]evm.RoyaltyConfig{7: {Receiver: artist, FeeBasisPoints: 1_000}}
+
+			info, err := evm.GetRoyaltyInfo(context.Background(), tt.contract, testContract, big.NewInt(tt.tokenID), salePrice)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Receiver, info.Receiver)
+			assert.Zero(t, tt.want.Amount.Cmp(info.Amount), "amount %s", info.Amount)
+		})
+	}
+
+	t.Run("Negative sale price", func(t *testing.T) {
+		_, err := evm.GetRoyaltyInfo(context.Background(), &fakeRoyalty{t: t, erc2981: true}, testContract, big.NewInt(1), big.NewInt(-1))
+		assert.ErrorContains(t, err, "invalid sale price")
+	})
+}
@@ -0,0 +1,227 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+// CompactSignatureLength is the length of an EIP-2098 signature, [R || yParity << 255 | S]
+const CompactSignatureLength = 64
+
+var (
+	// ErrMalformedSignature is returned for signatures the contract would reject before recovering a signer
+	ErrMalformedSignature = errors.New("malformed signature")
+	// ErrDomainMismatch is returned when a domain does not hash to the DOMAIN_SEPARATOR of its contract
+	ErrDomainMismatch = errors.New("domain does not match the contract")
+	// ErrInvalidSignature is returned when a signature is not from the permit owner
+	ErrInvalidSignature = errors.New("signature is not from the permit owner")
+)
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// PermitMessage is a struct a certificate contract verifies signatures of: Permit, PermitForAll or TransferPermit
+type PermitMessage interface {
+	Hash(domain EIP712Domain) ([]byte, error)
+	// PermitOwner is the account that must have signed the message
+	PermitOwner() common.Address
+}
+
+var (
+	_ PermitMessage = Permit{}
+	_ PermitMessage = PermitForAll{}
+	_ PermitMessage = TransferPermit{}
+)
+
+func (p Permit) PermitOwner() common.Address         { return p.Owner }
+func (p PermitForAll) PermitOwner() common.Address   { return p.Owner }
+func (p TransferPermit) PermitOwner() common.Address { return p.Owner }
+
+// ParsePermitSignature decodes a 65 byte [R || S || V] signature or a 64 byte EIP-2098 compact one
+// V may be 0/1 or 27/28, the deadline is left empty
+func ParsePermitSignature(sig []byte) (*PermitSignature, error) {
+	switch len(sig) {
+	case crypto.SignatureLength:
+		signature, err := permitSignatureFromBytes(sig)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedSignature, err)
+		}
+		return signature, nil
+	case CompactSignatureLength:
+		signature := &PermitSignature{V: 27 + sig[32]>>7}
+		copy(signature.R[:], sig[:32])
+		copy(signature.S[:], sig[32:])
+		signature.S[0] &= 0x7f
+		return signature, nil
+	default:
+		return nil, fmt.Errorf("%w: signature is %d bytes, want %d or %d", ErrMalformedSignature, len(sig), crypto.SignatureLength, CompactSignatureLength)
+	}
+}
+
+// ParsePermitSignatureHex decodes a 0x prefixed hex signature, see ParsePermitSignature
+func ParsePermitSignatureHex(sig string) (*PermitSignature, error) {
+	data, err := hexutil.Decode(sig)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedSignature, err)
+	}
+	return ParsePermitSignature(data)
+}
+
+// Hex returns the 65 byte [R || S || V] form as 0x prefixed hex
+func (p *PermitSignature) Hex() string {
+	return hexutil.Encode(p.Bytes())
+}
+
+// Compact returns the EIP-2098 form, the signature must have a low S
+func (p *PermitSignature) Compact() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, CompactSignatureLength)
+	copy(sig[:32], p.R[:])
+	copy(sig[32:], p.S[:])
+	sig[32] |= (p.V - 27) << 7
+	return sig, nil
+}
+
+// CompactHex returns the EIP-2098 form as 0x prefixed hex
+func (p *PermitSignature) CompactHex() (string, error) {
+	sig, err := p.Compact()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(sig), nil
+}
+
+// Validate rejects signatures OpenZeppelin's ECDSA rejects: V other than 27/28, R or S out of range and a high S
+// For every valid signature (R, S, V) the signature (R, N-S, V^1) recovers the same key, only the low S form is accepted
+func (p *PermitSignature) Validate() error {
+	if p.V != 27 && p.V != 28 {
+		return fmt.Errorf("%w: invalid recovery byte %d", ErrMalformedSignature, p.V)
+	}
+
+	r, s := new(big.Int).SetBytes(p.R[:]), new(big.Int).SetBytes(p.S[:])
+	if r.Sign() == 0 || r.Cmp(secp256k1N) >= 0 || s.Sign() == 0 || s.Cmp(secp256k1N) >= 0 {
+		return fmt.Errorf("%w: R or S out of range", ErrMalformedSignature)
+	}
+
+	if s.Cmp(secp256k1HalfN) > 0 {
+		return fmt.Errorf("%w: S is in the upper half of the curve order, normalize it", ErrMalformedSignature)
+	}
+
+	return nil
+}
+
+// Normalize rewrites a high S signature to its low S twin, which recovers the same signer
+func (p *PermitSignature) Normalize() {
+	s := new(big.Int).SetBytes(p.S[:])
+	if s.Cmp(secp256k1HalfN) <= 0 {
+		return
+	}
+
+	s.Sub(secp256k1N, s)
+	p.S = common.BigToHash(s)
+	if p.V == 27 {
+		p.V = 28
+	} else if p.V == 28 {
+		p.V = 27
+	}
+}
+
+// DomainSeparator returns the EIP-712 domain separator, what DOMAIN_SEPARATOR() of the contract returns
+func (d EIP712Domain) DomainSeparator() (common.Hash, error) {
+	if err := d.validate(); err != nil {
+		return common.Hash{}, err
+	}
+
+	typedData := Permit{}.TypedData(d)
+	separator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	return common.BytesToHash(separator), nil
+}
+
+// VerifyPermitOffline recomputes the digest of message and checks sig is a valid signature by its owner key
+// It cannot verify EIP-1271 wallets nor that the domain matches the contract, use VerifyPermit for those
+func VerifyPermitOffline(domain EIP712Domain, message PermitMessage, sig *PermitSignature) error {
+	if err := sig.Validate(); err != nil {
+		return err
+	}
+
+	hash, err := message.Hash(domain)
+	if err != nil {
+		return err
+	}
+
+	valid, err := VerifyPermitSignature(message.PermitOwner(), sig, hash)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedSignature, err)
+	}
+	if !valid {
+		return fmt.Errorf("%w %s", ErrInvalidSignature, message.PermitOwner().Hex())
+	}
+
+	return nil
+}
+
+// VerifyPermitOnChain checks sig the way the contract at domain.VerifyingContract will
+// The domain must hash to the contract's DOMAIN_SEPARATOR(), so a wrong name, version or chain ID is caught before broadcasting
+// The digest is recomputed from message and the owner may be an EIP-1271 wallet
+func VerifyPermitOnChain(ctx context.Context, caller bind.ContractCaller, domain EIP712Domain, message PermitMessage, sig *PermitSignature) error {
+	if err := sig.Validate(); err != nil {
+		return err
+	}
+
+	separator, err := domain.DomainSeparator()
+	if err != nil {
+		return err
+	}
+
+	contract, err := assets.NewLBBCertCaller(domain.VerifyingContract, caller)
+	if err != nil {
+		return fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	onChain, err := contract.DOMAINSEPARATOR(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to call DOMAIN_SEPARATOR: %w", err)
+	}
+	if !bytes.Equal(separator[:], onChain[:]) {
+		return fmt.Errorf("%w %s: name %q, version %q or chain ID %s differs", ErrDomainMismatch, domain.VerifyingContract.Hex(), domain.Name, domain.Version, domain.ChainID)
+	}
+
+	hash, err := message.Hash(domain)
+	if err != nil {
+		return err
+	}
+
+	valid, err := IsValidSignatureNow(ctx, caller, message.PermitOwner(), hash, sig.Bytes())
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w %s", ErrInvalidSignature, message.PermitOwner().Hex())
+	}
+
+	return nil
+}
+
+// VerifyPermit checks sig against the contract at domain.VerifyingContract, see VerifyPermitOnChain
+func (e *EVMClient) VerifyPermit(domain EIP712Domain, message PermitMessage, sig *PermitSignature) error {
+	return VerifyPermitOnChain(e.GetClient().GetContext(), e.Backend(), domain, message, sig)
+}
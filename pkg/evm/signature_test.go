@@ -0,0 +1,214 @@
+package evm_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets"
+)
+
+// fakeDomainContract answers DOMAIN_SEPARATOR() of a certificate contract
+type fakeDomainContract struct {
+	bind.ContractCaller
+	t         *testing.T
+	address   common.Address
+	separator common.Hash
+}
+
+func (f *fakeDomainContract) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	if account == f.address {
+		return []byte{0x60, 0x80}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeDomainContract) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	contractABI, err := assets.LBBCertMetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method, err := contractABI.MethodById(call.Data[:4])
+	require.NoError(f.t, err)
+	require.Equal(f.t, "DOMAIN_SEPARATOR", method.Name)
+	require.Equal(f.t, f.address, *call.To)
+
+	return method.Outputs.Pack([32]byte(f.separator))
+}
+
+// highS returns the (R, N-S, V^1) twin of a signature, which recovers the same key
+func highS(sig *evm.PermitSignature) *evm.PermitSignature {
+	s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig.S[:]))
+	twin := *sig
+	twin.S = common.BigToHash(s)
+	twin.V = 55 - sig.V
+	return &twin
+}
+
+func TestPermitSignatureEncoding(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for _, message := range []string{"first", "second", "third", "fourth"} {
+		signature, err := evm.SignPermitMessage(key, crypto.Keccak256([]byte(message)))
+		require.NoError(t, err)
+		require.NoError(t, signature.Validate())
+
+		t.Run("Hex "+message, func(t *testing.T) {
+			decoded, err := evm.ParsePermitSignatureHex(signature.Hex())
+			require.NoError(t, err)
+			assert.Equal(t, signature, decoded)
+		})
+
+		t.Run("Compact "+message, func(t *testing.T) {
+			compact, err := signature.Compact()
+			require.NoError(t, err)
+			require.Len(t, compact, evm.CompactSignatureLength)
+
+			decoded, err := evm.ParsePermitSignature(compact)
+			require.NoError(t, err)
+			assert.Equal(t, signature, decoded)
+
+			compactHex, err := signature.CompactHex()
+			require.NoError(t, err)
+			decoded, err = evm.ParsePermitSignatureHex(compactHex)
+			require.NoError(t, err)
+			assert.Equal(t, signature, decoded)
+		})
+	}
+
+	signature, err := evm.SignPermitMessage(key, crypto.Keccak256([]byte("certificate")))
+	require.NoError(t, err)
+
+	t.Run("JSON string", func(t *testing.T) {
+		var decoded evm.PermitSignature
+		require.NoError(t, json.Unmarshal([]byte(`"`+signature.Hex()+`"`), &decoded))
+		assert.Equal(t, *signature, decoded)
+
+		assert.ErrorIs(t, json.Unmarshal([]byte(`"0x1234"`), &decoded), evm.ErrMalformedSignature)
+	})
+
+	t.Run("Recovery byte 0/1", func(t *testing.T) {
+		sig := signature.Bytes()
+		sig[64] -= 27
+
+		decoded, err := evm.ParsePermitSignature(sig)
+		require.NoError(t, err)
+		assert.Equal(t, signature, decoded)
+	})
+
+	t.Run("High S", func(t *testing.T) {
+		hash := crypto.Keccak256([]byte("certificate"))
+		malleable := highS(signature)
+
+		// The twin recovers the same key but the contract rejects it
+		valid, err := evm.VerifyPermitSignature(crypto.PubkeyToAddress(key.PublicKey), malleable, hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+		assert.ErrorIs(t, malleable.Validate(), evm.ErrMalformedSignature)
+
+		_, err = malleable.Compact()
+		assert.ErrorIs(t, err, evm.ErrMalformedSignature)
+
+		malleable.Normalize()
+		assert.Equal(t, signature, malleable)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			signature evm.PermitSignature
+		}{
+			{name: "Recovery byte", signature: evm.PermitSignature{V: 29, R: signature.R, S: signature.S}},
+			{name: "Zero R", signature: evm.PermitSignature{V: 27, S: signature.S}},
+			{name: "Zero S", signature: evm.PermitSignature{V: 27, R: signature.R}},
+			{name: "R above the curve order", signature: evm.PermitSignature{V: 27, R: common.MaxHash, S: signature.S}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.ErrorIs(t, tt.signature.Validate(), evm.ErrMalformedSignature)
+			})
+		}
+
+		_, err := evm.ParsePermitSignature(make([]byte, 63))
+		assert.ErrorIs(t, err, evm.ErrMalformedSignature)
+	})
+}
+
+func TestVerifyPermit(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := evm.NewKeySigner(key)
+
+	contract := common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	domain := evm.NewPermitDomain("MyNFTCert", big.NewInt(150), contract)
+	permit := evm.Permit{
+		Owner:    signer.Address(),
+		Spender:  common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D"),
+		TokenID:  big.NewInt(7),
+		Nonce:    big.NewInt(3),
+		Deadline: big.NewInt(1_900_000_000),
+	}
+
+	signature, err := evm.SignPermitOffline(signer, domain, permit)
+	require.NoError(t, err)
+
+	separator, err := domain.DomainSeparator()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte("MyNFTCert")),
+		crypto.Keccak256([]byte("1")),
+		common.BigToHash(big.NewInt(150)).Bytes(),
+		common.LeftPadBytes(contract.Bytes(), 32),
+	), separator)
+
+	caller := &fakeDomainContract{t: t, address: contract, separator: separator}
+
+	require.NoError(t, evm.VerifyPermitOffline(domain, permit, signature))
+	require.NoError(t, evm.VerifyPermitOnChain(context.Background(), caller, domain, permit, signature))
+
+	t.Run("Wrong chain ID", func(t *testing.T) {
+		other := domain
+		other.ChainID = big.NewInt(666)
+
+		// Signed and verified against the same wrong domain, only the contract notices
+		otherSignature, err := evm.SignPermitOffline(signer, other, permit)
+		require.NoError(t, err)
+		require.NoError(t, evm.VerifyPermitOffline(other, permit, otherSignature))
+
+		err = evm.VerifyPermitOnChain(context.Background(), caller, other, permit, otherSignature)
+		assert.ErrorIs(t, err, evm.ErrDomainMismatch)
+	})
+
+	t.Run("Altered message", func(t *testing.T) {
+		altered := permit
+		altered.TokenID = big.NewInt(8)
+
+		assert.ErrorIs(t, evm.VerifyPermitOffline(domain, altered, signature), evm.ErrInvalidSignature)
+		assert.ErrorIs(t, evm.VerifyPermitOnChain(context.Background(), caller, domain, altered, signature), evm.ErrInvalidSignature)
+	})
+
+	t.Run("High S", func(t *testing.T) {
+		err := evm.VerifyPermitOnChain(context.Background(), caller, domain, permit, highS(signature))
+		assert.ErrorIs(t, err, evm.ErrMalformedSignature)
+	})
+
+	t.Run("Transfer permit", func(t *testing.T) {
+		transfer := evm.TransferPermit{Owner: signer.Address(), To: permit.Spender, TokenID: permit.TokenID, Nonce: big.NewInt(0), Deadline: permit.Deadline}
+		transferSignature, err := evm.SignTransferPermitOffline(signer, domain, transfer)
+		require.NoError(t, err)
+
+		require.NoError(t, evm.VerifyPermitOnChain(context.Background(), caller, domain, transfer, transferSignature))
+		assert.ErrorIs(t, evm.VerifyPermitOffline(domain, permit, transferSignature), evm.ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,115 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/soulbound"
+)
+
+// ERC5192InterfaceID is the ERC-165 interface ID of soulbound tokens, the selector of locked(uint256)
+var ERC5192InterfaceID = [4]byte{0xb4, 0x5a, 0x3c, 0x0e}
+
+// TokenLockedError is returned by the transfer functions for a soulbound token that is locked
+type TokenLockedError struct {
+	Contract common.Address
+	TokenID  *big.Int
+}
+
+func (e *TokenLockedError) Error() string {
+	return fmt.Sprintf("token %s of %s is soulbound and locked, the issuer must unlock it first", e.TokenID, e.Contract.Hex())
+}
+
+// IsTokenLocked reports whether tokenID is a locked ERC-5192 token
+// Tokens of contracts that do not implement ERC-5192 are never locked
+func IsTokenLocked(ctx context.Context, caller bind.ContractCaller, contractAddress common.Address, tokenID *big.Int) (bool, error) {
+	contractABI, err := soulbound.LBBCertSoulboundMetaData.GetAbi()
+	if err != nil {
+		return false, err
+	}
+
+	contract, err := soulbound.NewLBBCertSoulboundCaller(contractAddress, caller)
+	if err != nil {
+		return false, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	supported, err := SupportsInterface(ctx, caller, contractAddress, ERC5192InterfaceID)
+	if err != nil || !supported {
+		return false, err
+	}
+
+	locked, err := contract.Locked(&bind.CallOpts{Context: ctx}, tokenID)
+	if err != nil {
+		return false, fmt.Errorf("failed to call locked: %w", decodeRPCError(contractABI, err))
+	}
+
+	return locked, nil
+}
+
+// IsTokenLocked reports whether tokenID of a certificate contract is a locked soulbound token
+func (e *EVMClient) IsTokenLocked(contractAddress common.Address, tokenID *big.Int) (bool, error) {
+	return IsTokenLocked(e.GetClient().GetContext(), e.Backend(), contractAddress, tokenID)
+}
+
+// requireTransferable fails with a TokenLockedError before a transfer the contract would revert
+func (e *EVMClient) requireTransferable(contractAddress common.Address, tokenID *big.Int) error {
+	locked, err := e.IsTokenLocked(contractAddress, tokenID)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return &TokenLockedError{Contract: contractAddress, TokenID: tokenID}
+	}
+	return nil
+}
+
+// DeploySoulboundCertificateContract deploys LBBCertSoulbound from a forge artifact of contracts/src/CertSoulbound.sol
+// Tokens are locked when minted, the SDK does not bundle the bytecode
+func (e *EVMClient) DeploySoulboundCertificateContract(artifactPath, contractName, symbol, nftSchemaCode string) (common.Address, *types.Transaction, error) {
+	return e.deployFromArtifact(artifactPath, soulbound.LBBCertSoulboundMetaData, contractName, symbol, e.certificateBaseURI(nftSchemaCode), e.GetEVMAddress())
+}
+
+// UnlockCertificate lets the holder of a soulbound token transfer it once, for recovery; only the issuer may call it
+func (e *EVMClient) UnlockCertificate(contractAddress common.Address, tokenID *big.Int) (*types.Transaction, error) {
+	contract, err := e.LBBCertSoulbound(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.Unlock(opts, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send unlock: %w", err)
+	}
+
+	return tx, nil
+}
+
+// LockCertificate locks a soulbound token the issuer unlocked and that was not transferred yet
+func (e *EVMClient) LockCertificate(contractAddress common.Address, tokenID *big.Int) (*types.Transaction, error) {
+	contract, err := e.LBBCertSoulbound(contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.Lock(opts, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send lock: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,108 @@
+package evm_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/soulbound"
+)
+
+// fakeSoulbound answers supportsInterface and locked of a certificate contract
+type fakeSoulbound struct {
+	bind.ContractCaller
+	t *testing.T
+	// erc165 is false for contracts that revert on supportsInterface
+	erc165   bool
+	erc5192  bool
+	unlocked map[int64]bool
+	failing  bool
+}
+
+func (f *fakeSoulbound) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if f.failing {
+		return nil, errors.New("connection refused")
+	}
+
+	contractABI, err := soulbound.LBBCertSoulboundMetaData.GetAbi()
+	require.NoError(f.t, err)
+
+	method, err := contractABI.MethodById(call.Data[:4])
+	require.NoError(f.t, err)
+
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	require.NoError(f.t, err)
+
+	switch method.Name {
+	case "supportsInterface":
+		if !f.erc165 {
+			return nil, revertRPCError{}
+		}
+		return method.Outputs.Pack(f.erc5192 && args[0].([4]byte) == evm.ERC5192InterfaceID)
+	case "locked":
+		tokenID := args[0].(*big.Int)
+		unlocked, ok := f.unlocked[tokenID.Int64()]
+		if !ok {
+			customError := contractABI.Errors["ERC721NonexistentToken"]
+			data, err := customError.Inputs.Pack(tokenID)
+			require.NoError(f.t, err)
+			return nil, revertRPCError{data: append(customError.ID[:4:4], data...)}
+		}
+		return method.Outputs.Pack(!unlocked)
+	default:
+		f.t.Fatalf("unexpected call to %s", method.Name)
+		return nil, nil
+	}
+}
+
+func TestIsTokenLocked(t *testing.T) {
+	contractABI, err := soulbound.LBBCertSoulboundMetaData.GetAbi()
+	require.NoError(t, err)
+	assert.Equal(t, contractABI.Methods["locked"].ID, evm.ERC5192InterfaceID[:])
+
+	tests := []struct {
+		name     string
+		contract *fakeSoulbound
+		tokenID  int64
+		locked   bool
+		err      string
+	}{
+		{name: "Locked", contract: &fakeSoulbound{erc165: true, erc5192: true}, tokenID: 1, locked: true},
+		{name: "Unlocked by the issuer", contract: &fakeSoulbound{erc165: true, erc5192: true}, tokenID: 2},
+		{name: "Transferable contract", contract: &fakeSoulbound{erc165: true}, tokenID: 1},
+		{name: "Contract without ERC-165", contract: &fakeSoulbound{}, tokenID: 1},
+		{name: "Nonexistent token", contract: &fakeSoulbound{erc165: true, erc5192: true}, tokenID: 9, err: "ERC721NonexistentToken"},
+		{name: "RPC failure", contract: &fakeSoulbound{failing: true}, tokenID: 1, err: "connection refused"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.contract.t = t
+			tt.contract.unlocked = map[int64]bool{1: false, 2: true}
+
+			locked, err := evm.IsTokenLocked(context.Background(), tt.contract, testContract, big.NewInt(tt.tokenID))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.locked, locked)
+		})
+	}
+
+	t.Run("Typed error", func(t *testing.T) {
+		var err error = &evm.TokenLockedError{Contract: common.HexToAddress("0x01"), TokenID: big.NewInt(1)}
+
+		var locked *evm.TokenLockedError
+		require.ErrorAs(t, err, &locked)
+		assert.Equal(t, big.NewInt(1), locked.TokenID)
+	})
+}
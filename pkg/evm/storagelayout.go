@@ -0,0 +1,175 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrIncompatibleStorageLayout is returned when an upgrade would move or retype state of the current implementation
+var ErrIncompatibleStorageLayout = errors.New("incompatible storage layout")
+
+// StorageLayout is the solc storage layout of a contract, as printed by `forge inspect <contract> storageLayout --json`
+type StorageLayout struct {
+	Storage []StorageVariable      `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable or struct member at a slot and a byte offset in it
+type StorageVariable struct {
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   uint64 `json:"offset"`
+	Slot     string `json:"slot"`
+	// Type is a key of StorageLayout.Types
+	Type string `json:"type"`
+}
+
+// StorageType describes a type of a storage layout, Key and Value are set for mappings, Base for arrays and Members for structs
+type StorageType struct {
+	Encoding      string            `json:"encoding"`
+	Label         string            `json:"label"`
+	NumberOfBytes string            `json:"numberOfBytes"`
+	Key           string            `json:"key,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Base          string            `json:"base,omitempty"`
+	Members       []StorageVariable `json:"members,omitempty"`
+}
+
+// ParseStorageLayout reads a storage layout from JSON
+// It accepts the output of forge inspect and a foundry artifact built with extra_output = ["storageLayout"]
+func ParseStorageLayout(data []byte) (*StorageLayout, error) {
+	var layout struct {
+		StorageLayout
+		Artifact *StorageLayout `json:"storageLayout"`
+	}
+	if err := json.Unmarshal(data, &layout); err != nil {
+		return nil, fmt.Errorf("failed to parse storage layout: %w", err)
+	}
+
+	if layout.Artifact != nil {
+		return layout.Artifact, nil
+	}
+	if layout.Storage == nil {
+		return nil, fmt.Errorf("storage layout has no storage field")
+	}
+
+	return &layout.StorageLayout, nil
+}
+
+// LoadStorageLayout reads a storage layout from a JSON file, see ParseStorageLayout
+func LoadStorageLayout(path string) (*StorageLayout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read storage layout: %w", err)
+	}
+
+	layout, err := ParseStorageLayout(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return layout, nil
+}
+
+// RecordStorageLayout writes the storage layout of a foundry artifact to layoutPath, the layout the next upgrade is checked against
+func RecordStorageLayout(artifactPath, layoutPath string) error {
+	artifact, err := LoadArtifact(artifactPath)
+	if err != nil {
+		return err
+	}
+	if artifact.StorageLayout == nil {
+		return fmt.Errorf("%s has no storage layout, build it with extra_output = [\"storageLayout\"]", artifactPath)
+	}
+
+	data, err := json.MarshalIndent(artifact.StorageLayout, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode storage layout: %w", err)
+	}
+
+	if err := os.WriteFile(layoutPath, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write storage layout: %w", err)
+	}
+
+	return nil
+}
+
+// CheckStorageLayout reports whether next can replace the implementation recorded in current behind a proxy
+// Every variable of current must keep its name, slot, offset and type, new variables may only follow them
+func CheckStorageLayout(current, next *StorageLayout) error {
+	problems := compareStorageVariables(current.Storage, current.Types, next.Storage, next.Types, map[string]bool{})
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrIncompatibleStorageLayout, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// compareStorageVariables describes how the variables of current changed in next, nothing when they all kept their place and type
+// A variable is matched by name, then by position, so an inserted variable shows up as the ones after it moving
+func compareStorageVariables(current []StorageVariable, currentTypes map[string]StorageType, next []StorageVariable, nextTypes map[string]StorageType, seen map[string]bool) []string {
+	byLabel := make(map[string]StorageVariable, len(next))
+	byPosition := make(map[string]StorageVariable, len(next))
+	for _, variable := range next {
+		byLabel[variable.Label] = variable
+		byPosition[fmt.Sprintf("%s/%d", variable.Slot, variable.Offset)] = variable
+	}
+
+	var problems []string
+	for _, variable := range current {
+		match, ok := byLabel[variable.Label]
+		if !ok {
+			match, ok = byPosition[fmt.Sprintf("%s/%d", variable.Slot, variable.Offset)]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s at slot %s was removed", variable.Label, variable.Slot))
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s at slot %s was renamed to %s", variable.Label, variable.Slot, match.Label))
+		}
+
+		if match.Slot != variable.Slot || match.Offset != variable.Offset {
+			problems = append(problems, fmt.Sprintf("%s moved from slot %s offset %d to slot %s offset %d", variable.Label, variable.Slot, variable.Offset, match.Slot, match.Offset))
+			continue
+		}
+
+		if !sameStorageType(variable.Type, currentTypes, match.Type, nextTypes, seen) {
+			problems = append(problems, fmt.Sprintf("%s at slot %s changed type from %s to %s", variable.Label, variable.Slot, currentTypes[variable.Type].Label, nextTypes[match.Type].Label))
+		}
+	}
+
+	return problems
+}
+
+// sameStorageType compares two types by their layout, type IDs differ between compilations and are not compared
+// seen stops recursion through structs that refer to themselves through a mapping
+func sameStorageType(current string, currentTypes map[string]StorageType, next string, nextTypes map[string]StorageType, seen map[string]bool) bool {
+	if current == "" || next == "" {
+		return current == next
+	}
+	if seen[current+"\x00"+next] {
+		return true
+	}
+	seen[current+"\x00"+next] = true
+
+	a, ok := currentTypes[current]
+	if !ok {
+		return false
+	}
+	b, ok := nextTypes[next]
+	if !ok {
+		return false
+	}
+
+	if a.Encoding != b.Encoding || a.Label != b.Label || a.NumberOfBytes != b.NumberOfBytes || len(a.Members) != len(b.Members) {
+		return false
+	}
+	if !sameStorageType(a.Key, currentTypes, b.Key, nextTypes, seen) ||
+		!sameStorageType(a.Value, currentTypes, b.Value, nextTypes, seen) ||
+		!sameStorageType(a.Base, currentTypes, b.Base, nextTypes, seen) {
+		return false
+	}
+
+	return len(compareStorageVariables(a.Members, currentTypes, b.Members, nextTypes, seen)) == 0
+}
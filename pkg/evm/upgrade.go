@@ -0,0 +1,161 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/upgradeable"
+)
+
+// EIP1967ImplementationSlot is the storage slot of the implementation of an ERC-1967 proxy, keccak256("eip1967.proxy.implementation") - 1
+var EIP1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// ErrNotProxy is returned for contracts without an implementation in the ERC-1967 slot
+var ErrNotProxy = errors.New("contract is not an ERC-1967 proxy")
+
+// LBBCertProxyMetaData holds the ABI of LBBCertProxy in contracts/src/CertUpgradeable.sol
+var LBBCertProxyMetaData = &bind.MetaData{
+	ABI: `[{"type":"constructor","stateMutability":"payable","inputs":[{"name":"implementation","type":"address"},{"name":"data","type":"bytes"}]},` +
+		`{"type":"event","name":"Upgraded","anonymous":false,"inputs":[{"name":"implementation","type":"address","indexed":true}]},` +
+		`{"type":"error","name":"AddressEmptyCode","inputs":[{"name":"target","type":"address"}]},` +
+		`{"type":"error","name":"ERC1967InvalidImplementation","inputs":[{"name":"implementation","type":"address"}]},` +
+		`{"type":"error","name":"ERC1967NonPayable","inputs":[]},` +
+		`{"type":"error","name":"FailedCall","inputs":[]}]`,
+}
+
+// StorageReader is the part of the EVM RPC used to read contract storage
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// GetImplementationAddress returns the implementation behind an ERC-1967 proxy, read from EIP1967ImplementationSlot
+func GetImplementationAddress(ctx context.Context, reader StorageReader, proxyAddress common.Address) (common.Address, error) {
+	value, err := reader.StorageAt(ctx, proxyAddress, EIP1967ImplementationSlot, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read implementation slot of %s: %w", proxyAddress.Hex(), err)
+	}
+
+	implementation := common.BytesToAddress(value)
+	if implementation == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%w: %s", ErrNotProxy, proxyAddress.Hex())
+	}
+
+	return implementation, nil
+}
+
+// UpgradeableDeployment is an LBBCertUpgradeable implementation and the proxy in front of it
+type UpgradeableDeployment struct {
+	// Proxy is the address of the certificate contract, it stays the same across upgrades
+	Proxy            common.Address
+	Implementation   common.Address
+	ProxyTx          *types.Transaction
+	ImplementationTx *types.Transaction
+}
+
+// DeployUpgradeableCertificateContract deploys LBBCertUpgradeable and an LBBCertProxy initialized with it, from forge artifacts of contracts/src/CertUpgradeable.sol
+// It waits for the implementation before sending the proxy, wait for ProxyTx before using the contract; the SDK does not bundle the bytecode
+func (e *EVMClient) DeployUpgradeableCertificateContract(implementationArtifactPath, proxyArtifactPath, contractName, symbol, nftSchemaCode string) (*UpgradeableDeployment, error) {
+	contractABI, err := upgradeable.LBBCertUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	initialize, err := contractABI.Pack("initialize", contractName, symbol, e.certificateBaseURI(nftSchemaCode), e.GetEVMAddress())
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack initialize: %w", err)
+	}
+
+	implementation, implementationTx, err := e.deployImplementation(implementationArtifactPath)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, proxyTx, err := e.deployFromArtifact(proxyArtifactPath, LBBCertProxyMetaData, implementation, initialize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy proxy: %w", err)
+	}
+
+	return &UpgradeableDeployment{
+		Proxy:            proxy,
+		Implementation:   implementation,
+		ProxyTx:          proxyTx,
+		ImplementationTx: implementationTx,
+	}, nil
+}
+
+// ImplementationAddress returns the implementation behind an upgradeable certificate contract
+func (e *EVMClient) ImplementationAddress(proxyAddress common.Address) (common.Address, error) {
+	return GetImplementationAddress(e.GetClient().GetContext(), e.GetClient().GetETHClient(), proxyAddress)
+}
+
+// UpgradeCertificateContract deploys a new LBBCertUpgradeable from a forge artifact and points the proxy at it
+// The storage layout of the artifact must extend the one recorded at layoutPath, see CheckStorageLayout
+// Once the upgrade is mined, record the new layout with RecordStorageLayout for the next one
+func (e *EVMClient) UpgradeCertificateContract(proxyAddress common.Address, implementationArtifactPath, layoutPath string) (common.Address, *types.Transaction, error) {
+	recorded, err := LoadStorageLayout(layoutPath)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	artifact, err := LoadArtifact(implementationArtifactPath)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if artifact.StorageLayout == nil {
+		return common.Address{}, nil, fmt.Errorf("%s has no storage layout, build it with extra_output = [\"storageLayout\"]", implementationArtifactPath)
+	}
+
+	if err := CheckStorageLayout(recorded, artifact.StorageLayout); err != nil {
+		return common.Address{}, nil, fmt.Errorf("cannot upgrade %s to %s: %w", proxyAddress.Hex(), implementationArtifactPath, err)
+	}
+
+	if _, err := e.ImplementationAddress(proxyAddress); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	if err := e.requireContractOwner(proxyAddress); err != nil {
+		return common.Address{}, nil, err
+	}
+
+	implementation, _, err := e.deployImplementation(implementationArtifactPath)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	contract, err := e.LBBCertUpgradeable(proxyAddress)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	opts, err := e.TransactOpts()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+
+	tx, err := contract.UpgradeToAndCall(opts, implementation, nil)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to send upgradeToAndCall: %w", err)
+	}
+
+	return implementation, tx, nil
+}
+
+// deployImplementation deploys LBBCertUpgradeable and waits for it, a proxy cannot point at code that is not there yet
+func (e *EVMClient) deployImplementation(artifactPath string) (common.Address, *types.Transaction, error) {
+	implementation, tx, err := e.deployFromArtifact(artifactPath, upgradeable.LBBCertUpgradeableMetaData)
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to deploy implementation: %w", err)
+	}
+
+	if _, err := e.GetClient().WaitForEVMTransaction(tx.Hash()); err != nil {
+		return common.Address{}, nil, fmt.Errorf("failed to deploy implementation: %w", err)
+	}
+
+	return implementation, tx, nil
+}
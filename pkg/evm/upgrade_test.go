@@ -0,0 +1,173 @@
+package evm_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm/assets/upgradeable"
+)
+
+const recordedLayoutPath = "../../contracts/layout/LBBCertUpgradeable.json"
+
+// fakeStorage answers StorageAt from a map of slots of one contract
+type fakeStorage map[common.Hash]common.Hash
+
+func (f fakeStorage) StorageAt(_ context.Context, _ common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	value := f[key]
+	return value.Bytes(), nil
+}
+
+func TestGetImplementationAddress(t *testing.T) {
+	slot := new(big.Int).Sub(crypto.Keccak256Hash([]byte("eip1967.proxy.implementation")).Big(), big.NewInt(1))
+	assert.Equal(t, common.BigToHash(slot), evm.EIP1967ImplementationSlot)
+
+	implementation := common.HexToAddress("0x3753C81072A56072840990D3D02f354Efb7425A3")
+
+	got, err := evm.GetImplementationAddress(context.Background(), fakeStorage{evm.EIP1967ImplementationSlot: common.BytesToHash(implementation.Bytes())}, testContract)
+	require.NoError(t, err)
+	assert.Equal(t, implementation, got)
+
+	_, err = evm.GetImplementationAddress(context.Background(), fakeStorage{}, testContract)
+	assert.ErrorIs(t, err, evm.ErrNotProxy)
+}
+
+func TestCheckStorageLayout(t *testing.T) {
+	recorded, err := evm.LoadStorageLayout(recordedLayoutPath)
+	require.NoError(t, err)
+	require.Len(t, recorded.Storage, 2)
+
+	// next copies the recorded layout so each case can change it
+	next := func(change func(*evm.StorageLayout)) *evm.StorageLayout {
+		data, err := json.Marshal(recorded)
+		require.NoError(t, err)
+
+		layout, err := evm.ParseStorageLayout(data)
+		require.NoError(t, err)
+		change(layout)
+		return layout
+	}
+
+	tests := []struct {
+		name   string
+		layout *evm.StorageLayout
+		err    string
+	}{
+		{
+			name:   "Same layout",
+			layout: next(func(*evm.StorageLayout) {}),
+		},
+		{
+			name: "Appended variable",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Storage = append(l.Storage, evm.StorageVariable{Label: "_version", Slot: "2", Type: "t_uint256"})
+			}),
+		},
+		{
+			name: "Type IDs of another compilation",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Types["t_string_storage_v2"] = l.Types["t_string_storage"]
+				l.Storage[0].Type = "t_string_storage_v2"
+			}),
+		},
+		{
+			name: "Removed variable",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Storage = l.Storage[:1]
+			}),
+			err: "_nonces at slot 1 was removed",
+		},
+		{
+			name: "Inserted variable",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Storage = []evm.StorageVariable{
+					{Label: "_version", Slot: "0", Type: "t_uint256"},
+					{Label: "_baseTokenURI", Slot: "1", Type: "t_string_storage"},
+					{Label: "_nonces", Slot: "2", Type: "t_mapping(t_address,t_uint256)"},
+				}
+			}),
+			err: "_baseTokenURI moved from slot 0 offset 0 to slot 1 offset 0",
+		},
+		{
+			name: "Changed type",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Types["t_mapping(t_address,t_uint128)"] = evm.StorageType{Encoding: "mapping", Key: "t_address", Label: "mapping(address => uint128)", NumberOfBytes: "32", Value: "t_uint128"}
+				l.Types["t_uint128"] = evm.StorageType{Encoding: "inplace", Label: "uint128", NumberOfBytes: "16"}
+				l.Storage[1].Type = "t_mapping(t_address,t_uint128)"
+			}),
+			err: "_nonces at slot 1 changed type from mapping(address => uint256) to mapping(address => uint128)",
+		},
+		{
+			name: "Renamed variable",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Storage[0].Label = "_uri"
+			}),
+			err: "_baseTokenURI at slot 0 was renamed to _uri",
+		},
+		{
+			name: "Replaced with a variable of the same size",
+			layout: next(func(l *evm.StorageLayout) {
+				l.Storage[0].Type = "t_uint256"
+			}),
+			err: "_baseTokenURI at slot 0 changed type from string to uint256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := evm.CheckStorageLayout(recorded, tt.layout)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, evm.ErrIncompatibleStorageLayout)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestRecordStorageLayout(t *testing.T) {
+	recorded, err := evm.LoadStorageLayout(recordedLayoutPath)
+	require.NoError(t, err)
+
+	contractABI, err := upgradeable.GetContractABIBytes()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	artifactPath := filepath.Join(dir, "LBBCertUpgradeable.json")
+	layoutPath := filepath.Join(dir, "layout.json")
+
+	t.Run("Artifact without a layout", func(t *testing.T) {
+		artifact, err := json.Marshal(map[string]interface{}{"abi": json.RawMessage(contractABI), "bytecode": map[string]string{"object": "0x00"}})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(artifactPath, artifact, 0o644))
+
+		assert.ErrorContains(t, evm.RecordStorageLayout(artifactPath, layoutPath), "has no storage layout")
+	})
+
+	t.Run("Records the layout of the artifact", func(t *testing.T) {
+		artifact, err := json.Marshal(map[string]interface{}{"abi": json.RawMessage(contractABI), "bytecode": map[string]string{"object": "0x00"}, "storageLayout": recorded})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(artifactPath, artifact, 0o644))
+
+		require.NoError(t, evm.RecordStorageLayout(artifactPath, layoutPath))
+
+		layout, err := evm.LoadStorageLayout(layoutPath)
+		require.NoError(t, err)
+		assert.Equal(t, recorded, layout)
+
+		// A foundry artifact is accepted as a recorded layout too
+		layout, err = evm.LoadStorageLayout(artifactPath)
+		require.NoError(t, err)
+		assert.Equal(t, recorded, layout)
+	})
+}
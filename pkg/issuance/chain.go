@@ -0,0 +1,110 @@
+package issuance
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+var (
+	_ MetadataLayer = (*ChainMetadata)(nil)
+	_ TokenLayer    = (*ChainTokens)(nil)
+)
+
+// ChainMetadata is the MetadataLayer of an account on the Cosmos layer
+type ChainMetadata struct {
+	account account.Account
+}
+
+func NewChainMetadata(a account.Account) *ChainMetadata {
+	return &ChainMetadata{
+		account: a,
+	}
+}
+
+func (c *ChainMetadata) MetadataExists(nftSchemaCode, tokenID string) (bool, error) {
+	return metadata.NewMetadata(c.account).HasNFTMetadata(nftSchemaCode, tokenID)
+}
+
+func (c *ChainMetadata) CreateMetadata(nftSchemaCode, tokenID string, info *metadata.CertificateInfo) (string, error) {
+	metadataMsg, err := metadata.NewMetadataMsg(c.account, nftSchemaCode)
+	if err != nil {
+		return "", err
+	}
+
+	msg, err := metadataMsg.BuildMintMetadataMsg(tokenID)
+	if info != nil {
+		msg, err = metadataMsg.BuildMintMetadataWithInfoMsg(tokenID, *info)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to build metadata: %w", err)
+	}
+
+	res, err := metadataMsg.BroadcastTxAndWait(msg)
+	if res != nil {
+		return res.TxHash, err
+	}
+	return "", err
+}
+
+func (c *ChainMetadata) FreezeMetadata(nftSchemaCode, tokenID string) (string, error) {
+	metadataMsg, err := metadata.NewMetadataMsg(c.account, nftSchemaCode)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := metadataMsg.FreezeCertificateAndWait(tokenID)
+	if res != nil {
+		return res.TxHash, err
+	}
+	return "", err
+}
+
+// ChainTokens is the TokenLayer of an account on the EVM layer
+type ChainTokens struct {
+	evmClient *evm.EVMClient
+}
+
+func NewChainTokens(evmClient *evm.EVMClient) *ChainTokens {
+	return &ChainTokens{
+		evmClient: evmClient,
+	}
+}
+
+func (c *ChainTokens) IsMinted(contractAddress common.Address, tokenID uint64) (bool, error) {
+	return c.evmClient.IsMinted(contractAddress, tokenID)
+}
+
+func (c *ChainTokens) Mint(contractAddress common.Address, tokenID uint64, recipient common.Address) (common.Hash, error) {
+	tx, err := c.evmClient.MintCertificateNFTToDestination(contractAddress, tokenID, recipient)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to mint token %d: %w", tokenID, err)
+	}
+
+	_, err = c.evmClient.GetClient().WaitForEVMTransaction(tx.Hash())
+	return tx.Hash(), err
+}
+
+// Burn burns a token held by the issuer, tokens minted to another recipient need their owner's permit
+func (c *ChainTokens) Burn(contractAddress common.Address, tokenID uint64) (common.Hash, error) {
+	tx, err := c.evmClient.BurnCertificateNFT(contractAddress, tokenID)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to burn token %d: %w", tokenID, err)
+	}
+
+	_, err = c.evmClient.GetClient().WaitForEVMTransaction(tx.Hash())
+	return tx.Hash(), err
+}
+
+func (c *ChainTokens) DefaultRecipient() common.Address {
+	return c.evmClient.GetEVMAddress()
+}
+
+// NewIssuer returns an Issuer creating metadata and minting tokens with the keys of a
+func NewIssuer(a account.Account, journal Journal) (*Issuer, error) {
+	return NewIssuerWithLayers(NewChainMetadata(a), NewChainTokens(evm.NewEVMClient(a)), journal)
+}
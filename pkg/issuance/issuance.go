@@ -0,0 +1,346 @@
+package issuance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+// ErrCompensated is returned when issuing a certificate whose earlier issuance was undone
+var ErrCompensated = errors.New("issuance was compensated")
+
+// Spec describes one certificate to issue on both layers
+type Spec struct {
+	NFTSchemaCode   string         `json:"nft_schema_code"`
+	ContractAddress common.Address `json:"contract_address"`
+	TokenID         uint64         `json:"token_id"`
+	// Recipient receives the NFT, the issuer's EVM address when empty
+	Recipient common.Address `json:"recipient,omitempty"`
+	// Info fills in the certificate attributes, the default metadata is used when nil
+	Info *metadata.CertificateInfo `json:"info,omitempty"`
+	// Compensate undoes a half-issued certificate: the NFT is burned when the metadata failed,
+	// the metadata is frozen when the mint failed
+	Compensate bool `json:"compensate,omitempty"`
+}
+
+// ID identifies the issuance in the journal
+func (s Spec) ID() string {
+	return fmt.Sprintf("%s/%s/%d", s.NFTSchemaCode, s.ContractAddress.Hex(), s.TokenID)
+}
+
+func (s Spec) Validate() error {
+	if s.NFTSchemaCode == "" {
+		return fmt.Errorf("schema code cannot be empty")
+	}
+
+	if s.ContractAddress == (common.Address{}) {
+		return fmt.Errorf("contract address cannot be the zero address")
+	}
+
+	return nil
+}
+
+// MetadataLayer is the Cosmos side of an issuance
+type MetadataLayer interface {
+	MetadataExists(nftSchemaCode, tokenID string) (bool, error)
+	CreateMetadata(nftSchemaCode, tokenID string, info *metadata.CertificateInfo) (txHash string, err error)
+	FreezeMetadata(nftSchemaCode, tokenID string) (txHash string, err error)
+}
+
+// TokenLayer is the EVM side of an issuance
+// Mint and Burn return once the transaction is mined
+type TokenLayer interface {
+	IsMinted(contractAddress common.Address, tokenID uint64) (bool, error)
+	Mint(contractAddress common.Address, tokenID uint64, recipient common.Address) (txHash common.Hash, err error)
+	Burn(contractAddress common.Address, tokenID uint64) (txHash common.Hash, err error)
+	DefaultRecipient() common.Address
+}
+
+// Issuer issues certificates on both layers and journals every step
+type Issuer struct {
+	metadata MetadataLayer
+	tokens   TokenLayer
+	journal  Journal
+	now      func() time.Time
+}
+
+func NewIssuerWithLayers(metadataLayer MetadataLayer, tokenLayer TokenLayer, journal Journal) (*Issuer, error) {
+	if metadataLayer == nil || tokenLayer == nil {
+		return nil, fmt.Errorf("metadata and token layers cannot be nil")
+	}
+
+	if journal == nil {
+		return nil, fmt.Errorf("journal cannot be nil")
+	}
+
+	return &Issuer{
+		metadata: metadataLayer,
+		tokens:   tokenLayer,
+		journal:  journal,
+		now:      time.Now,
+	}, nil
+}
+
+// IssueCertificate creates the metadata and mints the NFT of spec, running both legs in parallel
+// Running it again for the same spec resumes from the journal: legs found done on-chain are not sent again.
+// ctx is checked before each step, transactions already sent are still waited for
+func (i *Issuer) IssueCertificate(ctx context.Context, spec Spec) (*Record, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	record, err := i.loadRecord(spec)
+	if err != nil {
+		return nil, err
+	}
+	spec = record.Spec
+
+	switch record.Status {
+	case StatusIssued:
+		return record, nil
+	case StatusCompensated:
+		return record, fmt.Errorf("%s: %w", record.ID, ErrCompensated)
+	}
+
+	if err := i.reconcile(record); err != nil {
+		return record, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return record, err
+	}
+
+	var (
+		mu          sync.Mutex
+		wg          sync.WaitGroup
+		metadataErr error
+		nftErr      error
+	)
+
+	// Both layers have their own sequence, so the legs do not wait on each other
+	if !record.Metadata.IsDone() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			metadataErr = i.runLeg(&mu, record, &record.Metadata, func() (string, error) {
+				return i.metadata.CreateMetadata(spec.NFTSchemaCode, strconv.FormatUint(spec.TokenID, 10), spec.Info)
+			})
+		}()
+	}
+
+	if !record.NFT.IsDone() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nftErr = i.runLeg(&mu, record, &record.NFT, func() (string, error) {
+				txHash, err := i.tokens.Mint(spec.ContractAddress, spec.TokenID, i.recipient(spec))
+				return hashString(txHash), err
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	legErr := errors.Join(metadataErr, nftErr)
+	if legErr == nil {
+		record.Status = StatusIssued
+		record.Error = ""
+		return record, i.save(record)
+	}
+
+	record.Error = legErr.Error()
+	if spec.Compensate && record.Metadata.IsDone() != record.NFT.IsDone() {
+		if err := ctx.Err(); err != nil {
+			return record, errors.Join(legErr, err)
+		}
+		return record, errors.Join(legErr, i.compensate(record))
+	}
+
+	record.Status = StatusFailed
+	if err := i.save(record); err != nil {
+		return record, errors.Join(legErr, err)
+	}
+
+	return record, legErr
+}
+
+// Resume runs again every journaled issuance that is neither issued nor compensated
+// It returns the records it went through and the errors of those that still failed
+func (i *Issuer) Resume(ctx context.Context) ([]*Record, error) {
+	records, err := i.journal.Records()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		resumed []*Record
+		errs    []error
+	)
+	for _, record := range records {
+		if record.Status.Done() {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return resumed, errors.Join(append(errs, err)...)
+		}
+
+		result, err := i.IssueCertificate(ctx, record.Spec)
+		if result != nil {
+			resumed = append(resumed, result)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", record.ID, err))
+		}
+	}
+
+	return resumed, errors.Join(errs...)
+}
+
+// Record returns the journal entry of spec
+func (i *Issuer) Record(spec Spec) (*Record, bool, error) {
+	return i.journal.LoadRecord(spec.ID())
+}
+
+func (i *Issuer) loadRecord(spec Spec) (*Record, error) {
+	record, found, err := i.journal.LoadRecord(spec.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load journal record: %w", err)
+	}
+
+	if found {
+		// The journaled spec wins so a resume cannot change what was half issued
+		return record, nil
+	}
+
+	now := i.now()
+	record = &Record{
+		ID:        spec.ID(),
+		Spec:      spec,
+		Metadata:  Leg{Status: LegPending},
+		NFT:       Leg{Status: LegPending},
+		Status:    StatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return record, i.save(record)
+}
+
+// reconcile marks legs done when the chain already has them, a crash may have happened after sending but before journaling
+func (i *Issuer) reconcile(record *Record) error {
+	spec := record.Spec
+
+	if !record.Metadata.IsDone() {
+		exists, err := i.metadata.MetadataExists(spec.NFTSchemaCode, strconv.FormatUint(spec.TokenID, 10))
+		if err != nil {
+			return fmt.Errorf("failed to check metadata: %w", err)
+		}
+		if exists {
+			record.Metadata = Leg{Status: LegDone, TxHash: record.Metadata.TxHash, Detected: true}
+		}
+	}
+
+	if !record.NFT.IsDone() {
+		minted, err := i.tokens.IsMinted(spec.ContractAddress, spec.TokenID)
+		if err != nil {
+			return fmt.Errorf("failed to check token: %w", err)
+		}
+		if minted {
+			record.NFT = Leg{Status: LegDone, TxHash: record.NFT.TxHash, Detected: true}
+		}
+	}
+
+	return i.save(record)
+}
+
+// runLeg journals the leg as started, runs send and journals its outcome
+func (i *Issuer) runLeg(mu *sync.Mutex, record *Record, leg *Leg, send func() (string, error)) error {
+	mu.Lock()
+	leg.Status = LegStarted
+	leg.Error = ""
+	err := i.save(record)
+	mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	txHash, sendErr := send()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	leg.TxHash = txHash
+	if sendErr != nil {
+		leg.Status = LegFailed
+		leg.Error = sendErr.Error()
+	} else {
+		leg.Status = LegDone
+	}
+
+	if err := i.save(record); err != nil {
+		return errors.Join(sendErr, err)
+	}
+
+	return sendErr
+}
+
+// compensate undoes the leg that succeeded when the other one failed
+func (i *Issuer) compensate(record *Record) error {
+	spec := record.Spec
+
+	var (
+		leg    *Leg
+		txHash string
+		err    error
+	)
+	if record.NFT.IsDone() {
+		leg = &record.NFT
+		var hash common.Hash
+		hash, err = i.tokens.Burn(spec.ContractAddress, spec.TokenID)
+		txHash = hashString(hash)
+	} else {
+		leg = &record.Metadata
+		txHash, err = i.metadata.FreezeMetadata(spec.NFTSchemaCode, strconv.FormatUint(spec.TokenID, 10))
+	}
+
+	leg.CompensationTxHash = txHash
+	if err != nil {
+		record.Status = StatusCompensationFailed
+		err = fmt.Errorf("failed to compensate: %w", err)
+	} else {
+		leg.Status = LegCompensated
+		record.Status = StatusCompensated
+	}
+
+	return errors.Join(err, i.save(record))
+}
+
+func (i *Issuer) recipient(spec Spec) common.Address {
+	if spec.Recipient == (common.Address{}) {
+		return i.tokens.DefaultRecipient()
+	}
+	return spec.Recipient
+}
+
+func (i *Issuer) save(record *Record) error {
+	record.UpdatedAt = i.now()
+	if err := i.journal.SaveRecord(record); err != nil {
+		return fmt.Errorf("failed to save journal record: %w", err)
+	}
+	return nil
+}
+
+func hashString(hash common.Hash) string {
+	if hash == (common.Hash{}) {
+		return ""
+	}
+	return hash.Hex()
+}
@@ -0,0 +1,286 @@
+package issuance_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/issuance"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/metadata"
+)
+
+var (
+	testContract = common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	testIssuer   = common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+)
+
+// fakeLayers records what an Issuer sends to both layers
+type fakeLayers struct {
+	mu sync.Mutex
+
+	metadata map[string]bool
+	frozen   map[string]bool
+	minted   map[uint64]common.Address
+	burned   map[uint64]bool
+
+	createCalls int
+	mintCalls   int
+	createErr   error
+	mintErr     error
+	burnErr     error
+}
+
+func newFakeLayers() *fakeLayers {
+	return &fakeLayers{
+		metadata: make(map[string]bool),
+		frozen:   make(map[string]bool),
+		minted:   make(map[uint64]common.Address),
+		burned:   make(map[uint64]bool),
+	}
+}
+
+func (f *fakeLayers) MetadataExists(_, tokenID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.metadata[tokenID], nil
+}
+
+func (f *fakeLayers) CreateMetadata(_, tokenID string, _ *metadata.CertificateInfo) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.createCalls++
+	if f.createErr != nil {
+		return "", f.createErr
+	}
+	f.metadata[tokenID] = true
+	return "COSMOSHASH" + tokenID, nil
+}
+
+func (f *fakeLayers) FreezeMetadata(_, tokenID string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.frozen[tokenID] = true
+	return "FREEZEHASH" + tokenID, nil
+}
+
+func (f *fakeLayers) IsMinted(_ common.Address, tokenID uint64) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, minted := f.minted[tokenID]
+	return minted, nil
+}
+
+func (f *fakeLayers) Mint(_ common.Address, tokenID uint64, recipient common.Address) (common.Hash, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.mintCalls++
+	if f.mintErr != nil {
+		return common.Hash{}, f.mintErr
+	}
+	f.minted[tokenID] = recipient
+	return common.BigToHash(common.Big1), nil
+}
+
+func (f *fakeLayers) Burn(_ common.Address, tokenID uint64) (common.Hash, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.burnErr != nil {
+		return common.Hash{}, f.burnErr
+	}
+	delete(f.minted, tokenID)
+	f.burned[tokenID] = true
+	return common.BigToHash(common.Big2), nil
+}
+
+func (f *fakeLayers) DefaultRecipient() common.Address {
+	return testIssuer
+}
+
+func newTestIssuer(t *testing.T, layers *fakeLayers, journal issuance.Journal) *issuance.Issuer {
+	issuer, err := issuance.NewIssuerWithLayers(layers, layers, journal)
+	require.NoError(t, err)
+	return issuer
+}
+
+func testSpec(tokenID uint64) issuance.Spec {
+	return issuance.Spec{
+		NFTSchemaCode:   "sixprotocol.lbb_cert",
+		ContractAddress: testContract,
+		TokenID:         tokenID,
+	}
+}
+
+func TestIssueCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Issues both legs", func(t *testing.T) {
+		layers := newFakeLayers()
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		record, err := issuer.IssueCertificate(ctx, testSpec(1))
+		require.NoError(t, err)
+
+		assert.Equal(t, issuance.StatusIssued, record.Status)
+		assert.Equal(t, issuance.LegDone, record.Metadata.Status)
+		assert.Equal(t, "COSMOSHASH1", record.Metadata.TxHash)
+		assert.Equal(t, issuance.LegDone, record.NFT.Status)
+		assert.Equal(t, testIssuer, layers.minted[1])
+
+		// Issuing again is a no-op
+		_, err = issuer.IssueCertificate(ctx, testSpec(1))
+		require.NoError(t, err)
+		assert.Equal(t, 1, layers.createCalls)
+		assert.Equal(t, 1, layers.mintCalls)
+	})
+
+	t.Run("Detects legs already on-chain", func(t *testing.T) {
+		layers := newFakeLayers()
+		layers.minted[2] = testIssuer
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		record, err := issuer.IssueCertificate(ctx, testSpec(2))
+		require.NoError(t, err)
+
+		assert.Equal(t, issuance.StatusIssued, record.Status)
+		assert.True(t, record.NFT.Detected)
+		assert.Zero(t, layers.mintCalls)
+		assert.Equal(t, 1, layers.createCalls)
+	})
+
+	t.Run("Failed leg is retried on resume", func(t *testing.T) {
+		layers := newFakeLayers()
+		layers.mintErr = errors.New("nonce too low")
+		journal := issuance.NewMemoryJournal()
+		issuer := newTestIssuer(t, layers, journal)
+
+		record, err := issuer.IssueCertificate(ctx, testSpec(3))
+		require.ErrorContains(t, err, "nonce too low")
+		assert.Equal(t, issuance.StatusFailed, record.Status)
+		assert.Equal(t, issuance.LegDone, record.Metadata.Status)
+		assert.Equal(t, issuance.LegFailed, record.NFT.Status)
+
+		layers.mintErr = nil
+		resumed, err := issuer.Resume(ctx)
+		require.NoError(t, err)
+		require.Len(t, resumed, 1)
+		assert.Equal(t, issuance.StatusIssued, resumed[0].Status)
+		assert.Equal(t, 1, layers.createCalls, "Metadata should not be created twice")
+	})
+
+	t.Run("Failed mint freezes the metadata", func(t *testing.T) {
+		layers := newFakeLayers()
+		layers.mintErr = errors.New("execution reverted")
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		spec := testSpec(4)
+		spec.Compensate = true
+
+		record, err := issuer.IssueCertificate(ctx, spec)
+		require.Error(t, err)
+		assert.Equal(t, issuance.StatusCompensated, record.Status)
+		assert.Equal(t, issuance.LegCompensated, record.Metadata.Status)
+		assert.Equal(t, "FREEZEHASH4", record.Metadata.CompensationTxHash)
+		assert.True(t, layers.frozen["4"])
+
+		_, err = issuer.IssueCertificate(ctx, spec)
+		assert.ErrorIs(t, err, issuance.ErrCompensated)
+	})
+
+	t.Run("Failed metadata burns the token", func(t *testing.T) {
+		layers := newFakeLayers()
+		layers.createErr = errors.New("schema not found")
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		spec := testSpec(5)
+		spec.Compensate = true
+
+		record, err := issuer.IssueCertificate(ctx, spec)
+		require.Error(t, err)
+		assert.Equal(t, issuance.StatusCompensated, record.Status)
+		assert.Equal(t, issuance.LegCompensated, record.NFT.Status)
+		assert.True(t, layers.burned[5])
+	})
+
+	t.Run("Failed compensation needs attention", func(t *testing.T) {
+		layers := newFakeLayers()
+		layers.createErr = errors.New("schema not found")
+		layers.burnErr = errors.New("ERC721InsufficientApproval")
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		spec := testSpec(6)
+		spec.Compensate = true
+		spec.Recipient = common.HexToAddress("0x01")
+
+		record, err := issuer.IssueCertificate(ctx, spec)
+		require.ErrorContains(t, err, "failed to compensate")
+		assert.Equal(t, issuance.StatusCompensationFailed, record.Status)
+		assert.Equal(t, spec.Recipient, layers.minted[6])
+	})
+
+	t.Run("Cancelled context sends nothing", func(t *testing.T) {
+		layers := newFakeLayers()
+		issuer := newTestIssuer(t, layers, issuance.NewMemoryJournal())
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := issuer.IssueCertificate(cancelled, testSpec(7))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, layers.createCalls+layers.mintCalls)
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		issuer := newTestIssuer(t, newFakeLayers(), issuance.NewMemoryJournal())
+
+		_, err := issuer.IssueCertificate(ctx, issuance.Spec{NFTSchemaCode: "sixprotocol.lbb_cert"})
+		assert.Error(t, err)
+	})
+}
+
+func TestFileJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issuance.json")
+
+	journal, err := issuance.NewFileJournal(path)
+	require.NoError(t, err)
+
+	layers := newFakeLayers()
+	layers.mintErr = errors.New("connection refused")
+
+	spec := testSpec(8)
+	spec.Info = &metadata.CertificateInfo{CertNumber: "CERT-8", Status: metadata.CertStatusType_ACTIVE}
+
+	_, err = newTestIssuer(t, layers, journal).IssueCertificate(context.Background(), spec)
+	require.Error(t, err)
+
+	// A new process reading the same file resumes with the journaled spec
+	reopened, err := issuance.NewFileJournal(path)
+	require.NoError(t, err)
+
+	records, err := reopened.Records()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, issuance.StatusFailed, records[0].Status)
+	assert.Equal(t, "CERT-8", records[0].Spec.Info.CertNumber)
+
+	layers.mintErr = nil
+	resumed, err := newTestIssuer(t, layers, reopened).Resume(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resumed, 1)
+	assert.Equal(t, issuance.StatusIssued, resumed[0].Status)
+
+	record, found, err := reopened.LoadRecord(spec.ID())
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, issuance.StatusIssued, record.Status)
+}
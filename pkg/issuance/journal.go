@@ -0,0 +1,242 @@
+package issuance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status is the overall state of an issuance
+type Status string
+
+const (
+	StatusPending     Status = "pending"
+	StatusIssued      Status = "issued"
+	StatusFailed      Status = "failed"
+	StatusCompensated Status = "compensated"
+	// StatusCompensationFailed means a leg failed and undoing the other leg failed too, it needs manual attention
+	StatusCompensationFailed Status = "compensation_failed"
+)
+
+// Done reports whether the issuance reached a state that running it again will not change
+func (s Status) Done() bool {
+	return s == StatusIssued || s == StatusCompensated
+}
+
+// LegStatus is the state of one layer of an issuance
+type LegStatus string
+
+const (
+	LegPending LegStatus = "pending"
+	// LegStarted is journaled before a transaction is sent, on resume the chain tells whether it landed
+	LegStarted     LegStatus = "started"
+	LegDone        LegStatus = "done"
+	LegFailed      LegStatus = "failed"
+	LegCompensated LegStatus = "compensated"
+)
+
+// Leg is the journaled state of the Cosmos metadata or the EVM token of an issuance
+type Leg struct {
+	Status LegStatus `json:"status"`
+	TxHash string    `json:"tx_hash,omitempty"`
+	// Detected is set when the leg was found done on-chain rather than by a transaction of this journal
+	Detected           bool   `json:"detected,omitempty"`
+	Error              string `json:"error,omitempty"`
+	CompensationTxHash string `json:"compensation_tx_hash,omitempty"`
+}
+
+func (l Leg) IsDone() bool {
+	return l.Status == LegDone || l.Status == LegCompensated
+}
+
+// Record is the journal entry of one certificate issuance
+type Record struct {
+	ID        string    `json:"id"`
+	Spec      Spec      `json:"spec"`
+	Metadata  Leg       `json:"metadata"`
+	NFT       Leg       `json:"nft"`
+	Status    Status    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Journal durably stores issuance records so an interrupted issuance can be resumed
+type Journal interface {
+	LoadRecord(id string) (record *Record, found bool, err error)
+	SaveRecord(record *Record) error
+	Records() ([]*Record, error)
+}
+
+var (
+	_ Journal = (*MemoryJournal)(nil)
+	_ Journal = (*FileJournal)(nil)
+)
+
+// MemoryJournal keeps records in memory, they are lost when the process exits
+type MemoryJournal struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+func NewMemoryJournal() *MemoryJournal {
+	return &MemoryJournal{
+		records: make(map[string]Record),
+	}
+}
+
+func (j *MemoryJournal) LoadRecord(id string) (*Record, bool, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	record, found := j.records[id]
+	if !found {
+		return nil, false, nil
+	}
+	return &record, true, nil
+}
+
+func (j *MemoryJournal) SaveRecord(record *Record) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.records[record.ID] = *record
+	return nil
+}
+
+func (j *MemoryJournal) Records() ([]*Record, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	records := make([]*Record, 0, len(j.records))
+	for _, record := range j.records {
+		record := record
+		records = append(records, &record)
+	}
+	sortRecords(records)
+
+	return records, nil
+}
+
+// FileJournal keeps records in a JSON file
+// Every save rewrites the file through a temporary file and a rename so a crash never leaves it half written
+type FileJournal struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileJournal(path string) (*FileJournal, error) {
+	if path == "" {
+		return nil, fmt.Errorf("journal file path cannot be empty")
+	}
+
+	return &FileJournal{
+		path: path,
+	}, nil
+}
+
+func (j *FileJournal) LoadRecord(id string) (*Record, bool, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	records, err := j.read()
+	if err != nil {
+		return nil, false, err
+	}
+
+	record, found := records[id]
+	return record, found, nil
+}
+
+func (j *FileJournal) SaveRecord(record *Record) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	records, err := j.read()
+	if err != nil {
+		return err
+	}
+	records[record.ID] = record
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode journal: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(j.path), filepath.Base(j.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write journal file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write journal file: %w", err)
+	}
+
+	// The journal is what makes resuming safe, so make sure it reached the disk before the rename
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write journal file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write journal file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), j.path); err != nil {
+		return fmt.Errorf("failed to replace journal file: %w", err)
+	}
+
+	return nil
+}
+
+func (j *FileJournal) Records() ([]*Record, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	records, err := j.read()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*Record, 0, len(records))
+	for _, record := range records {
+		list = append(list, record)
+	}
+	sortRecords(list)
+
+	return list, nil
+}
+
+func (j *FileJournal) read() (map[string]*Record, error) {
+	records := make(map[string]*Record)
+
+	data, err := os.ReadFile(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read journal file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode journal file %s: %w", j.path, err)
+	}
+
+	return records, nil
+}
+
+func sortRecords(records []*Record) {
+	sort.Slice(records, func(a, b int) bool {
+		if records[a].CreatedAt.Equal(records[b].CreatedAt) {
+			return records[a].ID < records[b].ID
+		}
+		return records[a].CreatedAt.Before(records[b].CreatedAt)
+	})
+}
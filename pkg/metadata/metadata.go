@@ -1,10 +1,16 @@
 package metadata
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/thesixnetwork/lbb-sdk-go/account"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/address"
 )
 
 type Metadata struct {
@@ -14,6 +20,7 @@ type Metadata struct {
 type MetadataI interface {
 	GetNFTSchema(string) (nftmngrtypes.NFTSchemaQueryResult, error)
 	GetNFTMetadata(string, string) (nftmngrtypes.NftData, error)
+	HasNFTMetadata(string, string) (bool, error)
 	GetExecutor(string) ([]string, error)
 	GetIsExecutor(string, string) (bool, error)
 	GetAccount() account.Account
@@ -84,6 +91,61 @@ func (m *Metadata) GetNFTMetadata(nftSchemaCode, tokenID string) (nftmngrtypes.N
 	}, nil
 }
 
+// HasNFTMetadata reports whether metadata of tokenID exists in the schema
+// Unlike GetNFTMetadata it returns query failures instead of treating them as missing metadata
+func (m *Metadata) HasNFTMetadata(nftSchemaCode, tokenID string) (bool, error) {
+	goCtx := m.account.GetClient().GetContext()
+	clientCtx := m.account.GetClient().GetClientCTX()
+
+	queryClient := nftmngrtypes.NewQueryClient(clientCtx)
+
+	_, err := queryClient.NftData(goCtx, &nftmngrtypes.QueryGetNftDataRequest{
+		NftSchemaCode: nftSchemaCode,
+		TokenId:       tokenID,
+	})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to query metadata %s of %s: %w", tokenID, nftSchemaCode, err)
+	}
+
+	return true, nil
+}
+
+// GetNFTCollection returns one page of the metadata of a schema and the key of the next page, nil on the last page
+func (m *Metadata) GetNFTCollection(nftSchemaCode string, pageKey []byte, limit uint64) ([]nftmngrtypes.NftData, []byte, error) {
+	goCtx := m.account.GetClient().GetContext()
+	clientCtx := m.account.GetClient().GetClientCTX()
+
+	queryClient := nftmngrtypes.NewQueryClient(clientCtx)
+
+	res, err := queryClient.NftCollection(goCtx, &nftmngrtypes.QueryGetNftCollectionRequest{
+		NftSchemaCode: nftSchemaCode,
+		Pagination: &query.PageRequest{
+			Key:   pageKey,
+			Limit: limit,
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query collection of %s: %w", nftSchemaCode, err)
+	}
+
+	collection := make([]nftmngrtypes.NftData, 0, len(res.NftCollection))
+	for _, nftData := range res.NftCollection {
+		if nftData != nil {
+			collection = append(collection, *nftData)
+		}
+	}
+
+	var nextKey []byte
+	if res.Pagination != nil {
+		nextKey = res.Pagination.NextKey
+	}
+
+	return collection, nextKey, nil
+}
+
 func (m *Metadata) GetExecutor(nftSchemaCode string) ([]string, error) {
 	goCtx := m.account.GetClient().GetContext()
 	clientCtx := m.account.GetClient().GetClientCTX()
@@ -105,11 +167,18 @@ func (m *Metadata) GetExecutor(nftSchemaCode string) ([]string, error) {
 	return executor, nil
 }
 
+// GetIsExecutor checks whether the address is an action executor of the schema
+// The executor may be given as a 6x bech32 or a 0x hex address
 func (m *Metadata) GetIsExecutor(nftSchemaCode, executorAddress string) (bool, error) {
 	goCtx := m.account.GetClient().GetContext()
 	clientCtx := m.account.GetClient().GetClientCTX()
 	queryClient := nftmngrtypes.NewQueryClient(clientCtx)
 
+	executorAddress, err := address.ToBech32(executorAddress)
+	if err != nil {
+		return false, err
+	}
+
 	res, err := queryClient.ActionExecutor(goCtx, &nftmngrtypes.QueryGetActionExecutorRequest{
 		NftSchemaCode:   nftSchemaCode,
 		ExecutorAddress: executorAddress,
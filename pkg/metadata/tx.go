@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	nftmngrtypes "github.com/thesixnetwork/six-protocol/v4/x/nftmngr/types"
 
 	"github.com/thesixnetwork/lbb-sdk-go/account"
@@ -17,8 +18,17 @@ type MetadataMsg struct {
 	Metadata
 	accountMsg    *account.AccountMsg
 	nftSchemaCode string
+	// granter is the schema owner this operator acts for. See WithExecAs.
+	granter string
+	// actionHook runs after confirmed admin actions. See WithActionHook.
+	actionHook ActionHook
+	// originContractAddress is the certificate contract of the schema. See WithOriginContractAddress.
+	originContractAddress string
 }
 
+// ActionHook runs after an admin action on tokenID is confirmed on the Cosmos layer
+type ActionHook func(tokenID string, action string) error
+
 func NewMetadataMsg(a account.Account, nftSchemaCode string) (*MetadataMsg, error) {
 	accountMsg, err := account.NewAccountMsg(&a)
 	if err != nil {
@@ -42,6 +52,8 @@ func (b *MetadataMsg) BroadcastTxAndWait(msgs ...sdk.Msg) (*sdk.TxResponse, erro
 	return b.accountMsg.BroadcastTxAndWait(msgs...)
 }
 
+// BuildDeployMsg builds a MsgCreateNFTSchema owned by this account, also under WithExecAs
+// Creating a schema is not one of the operator messages in authz.OperatorMsgTypeURLs, an operator deploys its own schemas
 func (m *MetadataMsg) BuildDeployMsg() (msg *nftmngrtypes.MsgCreateNFTSchema, err error) {
 	var schemaInput nftmngrtypes.NFTSchemaINPUT
 	schemaInputBytes, err := assets.GetJSONSchema()
@@ -59,6 +71,12 @@ func (m *MetadataMsg) BuildDeployMsg() (msg *nftmngrtypes.MsgCreateNFTSchema, er
 	schemaInput.Owner = m.account.GetCosmosAddress().String()
 	schemaInput.Name = schemaName
 	schemaInput.Description = schemaName
+	if m.originContractAddress != "" {
+		if schemaInput.OriginData == nil {
+			schemaInput.OriginData = &nftmngrtypes.OriginData{}
+		}
+		schemaInput.OriginData.OriginContractAddress = m.originContractAddress
+	}
 
 	schemaBytes, err := m.GetCodec().(*codec.ProtoCodec).MarshalJSON(&schemaInput)
 	if err != nil {
@@ -108,7 +126,7 @@ func (m *MetadataMsg) BuildMintMetadataMsg(tokenID string) (msg *nftmngrtypes.Ms
 	metadataInput.NftSchemaCode = m.nftSchemaCode
 	metadataInput.OwnerAddressType = nftmngrtypes.OwnerAddressType_INTERNAL_ADDRESS
 	metadataInput.TokenId = tokenID
-	metadataInput.TokenOwner = m.account.GetCosmosAddress().String()
+	metadataInput.TokenOwner = m.creator()
 
 	metadataBytes, err = m.GetCodec().(*codec.ProtoCodec).MarshalJSON(&metadataInput)
 	if err != nil {
@@ -118,7 +136,7 @@ func (m *MetadataMsg) BuildMintMetadataMsg(tokenID string) (msg *nftmngrtypes.Ms
 	base64Metadata := base64.StdEncoding.EncodeToString(metadataBytes)
 
 	msg = &nftmngrtypes.MsgCreateMetadata{
-		Creator:       m.account.GetCosmosAddress().String(),
+		Creator:       m.creator(),
 		NftSchemaCode: m.nftSchemaCode,
 		TokenId:       tokenID,
 		Base64NFTData: base64Metadata,
@@ -142,7 +160,7 @@ func (m *MetadataMsg) BuildMintMetadataWithInfoMsg(tokenID string, info Certific
 	metadataInput.NftSchemaCode = m.nftSchemaCode
 	metadataInput.OwnerAddressType = nftmngrtypes.OwnerAddressType_INTERNAL_ADDRESS
 	metadataInput.TokenId = tokenID
-	metadataInput.TokenOwner = m.account.GetCosmosAddress().String()
+	metadataInput.TokenOwner = m.creator()
 
 	certStatus := InactiveCertStr
 	if info.Status == 1 {
@@ -204,7 +222,7 @@ func (m *MetadataMsg) BuildMintMetadataWithInfoMsg(tokenID string, info Certific
 	base64Metadata := base64.StdEncoding.EncodeToString(metadataBytes)
 
 	msg = &nftmngrtypes.MsgCreateMetadata{
-		Creator:       m.account.GetCosmosAddress().String(),
+		Creator:       m.creator(),
 		NftSchemaCode: m.nftSchemaCode,
 		TokenId:       tokenID,
 		Base64NFTData: base64Metadata,
@@ -232,7 +250,7 @@ func (m *MetadataMsg) CreateCertificateMetadata(tokenID string) (res *sdk.TxResp
 }
 
 func (m *MetadataMsg) CreateCertificateMetadataWithInfo(tokenID string, info CertificateInfo) (res *sdk.TxResponse, err error) {
-	msg, err := m.BuildMintMetadataMsg(tokenID)
+	msg, err := m.BuildMintMetadataWithInfoMsg(tokenID, info)
 	if err != nil {
 		return res, err
 	}
@@ -249,26 +267,153 @@ func (m *MetadataMsg) CreateCertificateMetadataWithInfo(tokenID string, info Cer
 	return res, nil
 }
 
+// BuildFreezeCertificateMsg builds the admin action locking the metadata of a certificate
+func (m *MetadataMsg) BuildFreezeCertificateMsg(tokenID string) *nftmngrtypes.MsgPerformActionByAdmin {
+	return m.buildAdminActionMsg(tokenID, "freeze_cert")
+}
+
+// BuildUnfreezeCertificateMsg builds the admin action unlocking the metadata of a certificate
+func (m *MetadataMsg) BuildUnfreezeCertificateMsg(tokenID string) *nftmngrtypes.MsgPerformActionByAdmin {
+	return m.buildAdminActionMsg(tokenID, "unfreeze_cert")
+}
+
 func (m MetadataMsg) FreezeCertificate(tokenID string) (res *sdk.TxResponse, err error) {
-	msg := &nftmngrtypes.MsgPerformActionByAdmin{
-		Creator:       m.account.GetCosmosAddress().String(),
+	return m.BroadcastTx(m.BuildFreezeCertificateMsg(tokenID))
+}
+
+// FreezeCertificateAndWait locks the metadata of a certificate and waits for the transaction
+// The action hook, if any, runs once the transaction is confirmed
+func (m *MetadataMsg) FreezeCertificateAndWait(tokenID string) (res *sdk.TxResponse, err error) {
+	return m.performActionAndWait(m.BuildFreezeCertificateMsg(tokenID))
+}
+
+func (m MetadataMsg) UnfreezeCertificate(tokenID string) (res *sdk.TxResponse, err error) {
+	return m.BroadcastTx(m.BuildUnfreezeCertificateMsg(tokenID))
+}
+
+// UnfreezeCertificateAndWait unlocks the metadata of a certificate and waits for the transaction
+// The action hook, if any, runs once the transaction is confirmed
+func (m *MetadataMsg) UnfreezeCertificateAndWait(tokenID string) (res *sdk.TxResponse, err error) {
+	return m.performActionAndWait(m.BuildUnfreezeCertificateMsg(tokenID))
+}
+
+// performActionAndWait broadcasts an admin action, waits for it and then runs the action hook
+func (m *MetadataMsg) performActionAndWait(msg *nftmngrtypes.MsgPerformActionByAdmin) (*sdk.TxResponse, error) {
+	res, err := m.BroadcastTxAndWait(msg)
+	if err != nil {
+		return res, err
+	}
+
+	if m.actionHook == nil {
+		return res, nil
+	}
+
+	if err := m.actionHook(msg.TokenId, msg.Action); err != nil {
+		return res, fmt.Errorf("%s of token %s succeeded but its action hook failed: %w", msg.Action, msg.TokenId, err)
+	}
+
+	return res, nil
+}
+
+func (m *MetadataMsg) buildAdminActionMsg(tokenID, action string) *nftmngrtypes.MsgPerformActionByAdmin {
+	return &nftmngrtypes.MsgPerformActionByAdmin{
+		Creator:       m.creator(),
 		NftSchemaCode: m.nftSchemaCode,
 		TokenId:       tokenID,
-		Action:        "freeze_cert",
+		Action:        action,
 		RefId:         "",
 		Parameters:    []*nftmngrtypes.ActionParameter{},
 	}
-	return m.BroadcastTx(msg)
 }
 
-func (m MetadataMsg) UnfreezeCertificate(tokenID string) (res *sdk.TxResponse, err error) {
-	msg := &nftmngrtypes.MsgPerformActionByAdmin{
-		Creator:       m.account.GetCosmosAddress().String(),
+// BuildChangeSchemaOwnerMsg builds a MsgChangeSchemaOwner handing the schema to newOwner
+func (m *MetadataMsg) BuildChangeSchemaOwnerMsg(newOwner string) (*nftmngrtypes.MsgChangeSchemaOwner, error) {
+	newOwnerAddr, err := sdk.AccAddressFromBech32(newOwner)
+	if err != nil {
+		return nil, fmt.Errorf("invalid new owner address %s: %w", newOwner, err)
+	}
+
+	return &nftmngrtypes.MsgChangeSchemaOwner{
+		Creator:       m.creator(),
 		NftSchemaCode: m.nftSchemaCode,
-		TokenId:       tokenID,
-		Action:        "unfreeze_cert",
-		RefId:         "",
-		Parameters:    []*nftmngrtypes.ActionParameter{},
+		NewOwner:      newOwnerAddr.String(),
+	}, nil
+}
+
+// ChangeSchemaOwner hands the schema to newOwner
+func (m *MetadataMsg) ChangeSchemaOwner(newOwner string) (res *sdk.TxResponse, err error) {
+	msg, err := m.BuildChangeSchemaOwnerMsg(newOwner)
+	if err != nil {
+		return res, err
 	}
+
 	return m.BroadcastTx(msg)
 }
+
+// ChangeSchemaOwnerAndWait hands the schema to newOwner, waits for the transaction and checks the new owner on-chain
+func (m *MetadataMsg) ChangeSchemaOwnerAndWait(newOwner string) (res *sdk.TxResponse, err error) {
+	msg, err := m.BuildChangeSchemaOwnerMsg(newOwner)
+	if err != nil {
+		return res, err
+	}
+
+	res, err = m.BroadcastTxAndWait(msg)
+	if err != nil {
+		return res, err
+	}
+
+	schema, err := m.GetNFTSchema(m.nftSchemaCode)
+	if err != nil {
+		return res, err
+	}
+
+	if schema.Owner != msg.NewOwner {
+		return res, fmt.Errorf("owner of schema %s is %s after change, expected %s", m.nftSchemaCode, schema.Owner, msg.NewOwner)
+	}
+
+	return res, nil
+}
+
+// WithExecAs returns a new MetadataMsg that acts on behalf of the schema owner (granter)
+// Messages are built with the granter as creator and broadcast wrapped in an authz MsgExec,
+// so the granter must have granted this account the operator permissions beforehand
+func (m *MetadataMsg) WithExecAs(granter string) (*MetadataMsg, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid granter address %s: %w", granter, err)
+	}
+
+	newMetadataMsg := *m
+	newMetadataMsg.accountMsg = newMetadataMsg.accountMsg.WithExecAs(granterAddr)
+	newMetadataMsg.granter = granterAddr.String()
+	return &newMetadataMsg, nil
+}
+
+// WithActionHook returns a new MetadataMsg running hook after every admin action it waited for
+// FreezeCertificate and UnfreezeCertificate return before the block, so only their AndWait variants run it.
+// evm.EVMClient.MetadataUpdateHook emits the ERC-4906 refresh for the same token on the certificate contract
+func (m *MetadataMsg) WithActionHook(hook ActionHook) *MetadataMsg {
+	newMetadataMsg := *m
+	newMetadataMsg.actionHook = hook
+	return &newMetadataMsg
+}
+
+// WithOriginContractAddress returns a new MetadataMsg deploying its schema with contractAddress as origin contract
+// evm.EVMClient.PredictCertificateAddress gives the address before the contract is deployed
+func (m *MetadataMsg) WithOriginContractAddress(contractAddress string) (*MetadataMsg, error) {
+	if !common.IsHexAddress(contractAddress) {
+		return nil, fmt.Errorf("invalid contract address %s", contractAddress)
+	}
+
+	newMetadataMsg := *m
+	newMetadataMsg.originContractAddress = common.HexToAddress(contractAddress).Hex()
+	return &newMetadataMsg, nil
+}
+
+// creator returns the address messages are built for, the granter when acting as an operator
+func (m *MetadataMsg) creator() string {
+	if m.granter != "" {
+		return m.granter
+	}
+	return m.account.GetCosmosAddress().String()
+}
@@ -0,0 +1,111 @@
+package relayer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+var _ Chain = (*EVMChain)(nil)
+
+// EVMChain relays permits with the key of an EVMClient
+// It keeps the account nonce locally so permits go out back to back without waiting for each receipt
+type EVMChain struct {
+	evmClient *evm.EVMClient
+
+	mu    sync.Mutex
+	nonce *uint64
+}
+
+func NewEVMChain(evmClient *evm.EVMClient) *EVMChain {
+	return &EVMChain{
+		evmClient: evmClient,
+	}
+}
+
+func (c *EVMChain) Address() common.Address {
+	return c.evmClient.GetEVMAddress()
+}
+
+func (c *EVMChain) PermitDomain(contractAddress common.Address) (*evm.EIP712Domain, error) {
+	return c.evmClient.PermitDomain(contractAddress)
+}
+
+func (c *EVMChain) PermitNonce(contractAddress common.Address, owner common.Address) (*big.Int, error) {
+	return c.evmClient.GetPermitNonce(contractAddress, owner)
+}
+
+func (c *EVMChain) OwnerOf(contractAddress common.Address, tokenID *big.Int) (common.Address, error) {
+	contract, err := c.evmClient.LBBCert(contractAddress)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	return contract.OwnerOf(c.evmClient.CallOpts(), tokenID)
+}
+
+func (c *EVMChain) Relay(request *Request) (common.Hash, error) {
+	contract, err := c.evmClient.LBBCert(request.Contract)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to bind contract: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	opts, err := c.evmClient.TransactOpts()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if c.nonce == nil {
+		nonce, err := c.evmClient.GetNonce()
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		c.nonce = &nonce
+	}
+	opts.Nonce = new(big.Int).SetUint64(*c.nonce)
+
+	signature := request.Signature
+	var tx *types.Transaction
+	switch request.Kind {
+	case KindTransfer:
+		tx, err = contract.TransferWithPermit(opts, request.Owner, request.To, request.TokenID, signature.Deadline, signature.V, signature.R, signature.S)
+	case KindBurn:
+		tx, err = contract.BurnWithPermit(opts, request.Owner, request.TokenID, signature.Deadline, signature.V, signature.R, signature.S)
+	case KindPermitForAll:
+		tx, err = contract.PermitForAll(opts, request.Owner, request.Operator, request.Approved, signature.Deadline, signature.V, signature.R, signature.S)
+	default:
+		return common.Hash{}, fmt.Errorf("unknown permit kind %q", request.Kind)
+	}
+	if err != nil {
+		// The local nonce is out of step with the node, read it again for the next permit
+		if isNonceError(err) {
+			c.nonce = nil
+		}
+		return common.Hash{}, fmt.Errorf("failed to send %s permit: %w", request.Kind, err)
+	}
+
+	*c.nonce++
+	return tx.Hash(), nil
+}
+
+func (c *EVMChain) Wait(txHash common.Hash) error {
+	_, err := c.evmClient.GetClient().WaitForEVMTransaction(txHash)
+	return err
+}
+
+func isNonceError(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "nonce too low") ||
+		strings.Contains(message, "nonce too high") ||
+		strings.Contains(message, "invalid nonce") ||
+		strings.Contains(message, "already known")
+}
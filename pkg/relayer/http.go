@@ -0,0 +1,71 @@
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// maxRequestBytes bounds the body of a submitted permit
+const maxRequestBytes = 64 << 10
+
+// Handler serves the relayer API
+//
+//	POST /permits       submit a Request, answers 202 with its Status
+//	GET  /permits/{id}  poll the Status of a request
+//	GET  /healthz       liveness
+func (r *Relayer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /permits", r.handleSubmit)
+	mux.HandleFunc("GET /permits/{id}", r.handleStatus)
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	return mux
+}
+
+func (r *Relayer) handleSubmit(w http.ResponseWriter, req *http.Request) {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+
+	var request Request
+	if err := decoder.Decode(&request); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+		return
+	}
+
+	status, err := r.Submit(&request)
+	switch {
+	case err == nil:
+		writeJSON(w, http.StatusAccepted, status)
+	case errors.Is(err, ErrInvalidRequest):
+		writeError(w, http.StatusBadRequest, err)
+	case errors.Is(err, ErrRateLimited):
+		writeError(w, http.StatusTooManyRequests, err)
+	case errors.Is(err, ErrQueueFull):
+		writeError(w, http.StatusServiceUnavailable, err)
+	default:
+		writeError(w, http.StatusBadGateway, err)
+	}
+}
+
+func (r *Relayer) handleStatus(w http.ResponseWriter, req *http.Request) {
+	status, found := r.Status(req.PathValue("id"))
+	if !found {
+		writeError(w, http.StatusNotFound, fmt.Errorf("request %s not found", req.PathValue("id")))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
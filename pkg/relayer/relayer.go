@@ -0,0 +1,350 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+const (
+	DefaultRateLimit  = 10
+	DefaultRateWindow = time.Minute
+	DefaultQueueSize  = 1000
+	// DefaultDeadlineMargin is how long a permit must stay valid after it is accepted, so it does not expire in the queue
+	DefaultDeadlineMargin = time.Minute
+)
+
+var (
+	// ErrInvalidRequest is returned for permits that would revert on-chain
+	ErrInvalidRequest = errors.New("invalid permit request")
+	ErrRateLimited    = errors.New("rate limit exceeded")
+	ErrQueueFull      = errors.New("relay queue is full")
+)
+
+// Chain is what the relayer reads and sends on the EVM layer
+type Chain interface {
+	// Address is the relayer's address, the spender of transfer and burn permits
+	Address() common.Address
+	PermitDomain(contractAddress common.Address) (*evm.EIP712Domain, error)
+	PermitNonce(contractAddress common.Address, owner common.Address) (*big.Int, error)
+	OwnerOf(contractAddress common.Address, tokenID *big.Int) (common.Address, error)
+	// Relay broadcasts the permit with the relayer's key
+	Relay(request *Request) (common.Hash, error)
+	// Wait returns once the transaction is mined, with an error when it reverted
+	Wait(txHash common.Hash) error
+}
+
+// Config limits what the relayer accepts
+type Config struct {
+	// Contracts are the contracts the relayer pays gas for, any contract when empty
+	Contracts []common.Address
+	// RateLimit is the number of permits an owner may submit per RateWindow
+	RateLimit      int
+	RateWindow     time.Duration
+	QueueSize      int
+	DeadlineMargin time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		RateLimit:      DefaultRateLimit,
+		RateWindow:     DefaultRateWindow,
+		QueueSize:      DefaultQueueSize,
+		DeadlineMargin: DefaultDeadlineMargin,
+	}
+}
+
+// pendingKey counts the queued permits of an owner on a contract, each one uses the next permit nonce
+type pendingKey struct {
+	contract common.Address
+	owner    common.Address
+}
+
+// Relayer validates signed permits off-chain and broadcasts them one at a time with its own key
+type Relayer struct {
+	chain  Chain
+	config Config
+	queue  chan *Request
+	now    func() time.Time
+
+	// submitMu serializes submissions so concurrent permits of one owner get consecutive nonces
+	submitMu sync.Mutex
+
+	mu        sync.Mutex
+	statuses  map[string]*Status
+	pending   map[pendingKey]int64
+	submitted map[common.Address][]time.Time
+}
+
+func New(chain Chain, config Config) (*Relayer, error) {
+	if chain == nil {
+		return nil, fmt.Errorf("chain cannot be nil")
+	}
+
+	if config.RateLimit <= 0 {
+		config.RateLimit = DefaultRateLimit
+	}
+	if config.RateWindow <= 0 {
+		config.RateWindow = DefaultRateWindow
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultQueueSize
+	}
+	if config.DeadlineMargin < 0 {
+		return nil, fmt.Errorf("deadline margin cannot be negative")
+	}
+
+	return &Relayer{
+		chain:     chain,
+		config:    config,
+		queue:     make(chan *Request, config.QueueSize),
+		now:       time.Now,
+		statuses:  make(map[string]*Status),
+		pending:   make(map[pendingKey]int64),
+		submitted: make(map[common.Address][]time.Time),
+	}, nil
+}
+
+// Submit validates a permit and queues it for broadcasting
+// Submitting a permit already known returns its status
+func (r *Relayer) Submit(request *Request) (*Status, error) {
+	if err := request.resolve(r.chain.Address()); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	r.submitMu.Lock()
+	defer r.submitMu.Unlock()
+
+	if status, found := r.Status(request.ID()); found {
+		return status, nil
+	}
+
+	if !r.allowed(request.Contract) {
+		return nil, fmt.Errorf("%w: contract %s is not relayed", ErrInvalidRequest, request.Contract.Hex())
+	}
+
+	now := r.now()
+	if request.Signature.Deadline.Cmp(big.NewInt(now.Add(r.config.DeadlineMargin).Unix())) < 0 {
+		return nil, fmt.Errorf("%w: permit expires before it can be relayed", ErrInvalidRequest)
+	}
+
+	if err := r.rateLimit(request.Owner, now); err != nil {
+		return nil, err
+	}
+
+	if err := r.verify(request); err != nil {
+		return nil, err
+	}
+
+	status := &Status{
+		ID:        request.ID(),
+		Kind:      request.Kind,
+		Owner:     request.Owner,
+		State:     StateQueued,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	select {
+	case r.queue <- request:
+	default:
+		return nil, ErrQueueFull
+	}
+
+	r.statuses[status.ID] = status
+	r.pending[pendingKey{request.Contract, request.Owner}]++
+
+	copied := *status
+	return &copied, nil
+}
+
+// Status returns the state of a submitted request
+func (r *Relayer) Status(id string) (*Status, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status, found := r.statuses[id]
+	if !found {
+		return nil, false
+	}
+
+	copied := *status
+	return &copied, true
+}
+
+// Run broadcasts queued permits until ctx is done, then waits for the transactions already sent
+func (r *Relayer) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case request := <-r.queue:
+			txHash, err := r.relay(request)
+			if err != nil {
+				r.finish(request, err)
+				continue
+			}
+
+			// Receipts are waited for in the background so the next permit goes out with the next account nonce
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.finish(request, r.chain.Wait(txHash))
+			}()
+		}
+	}
+}
+
+// verify checks the permit the way the contract will: owner, nonce and signature
+func (r *Relayer) verify(request *Request) error {
+	if request.Kind != KindPermitForAll {
+		owner, err := r.chain.OwnerOf(request.Contract, request.TokenID)
+		if err != nil {
+			return fmt.Errorf("failed to get owner of token %s: %w", request.TokenID, err)
+		}
+		if owner != request.Owner {
+			return fmt.Errorf("%w: token %s is not held by %s", ErrInvalidRequest, request.TokenID, request.Owner.Hex())
+		}
+	}
+
+	nonce, err := r.chain.PermitNonce(request.Contract, request.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get permit nonce: %w", err)
+	}
+
+	// Permits still queued will each use a nonce before this one
+	r.mu.Lock()
+	expected := new(big.Int).Add(nonce, big.NewInt(r.pending[pendingKey{request.Contract, request.Owner}]))
+	r.mu.Unlock()
+
+	if request.Nonce != nil && request.Nonce.Cmp(expected) != 0 {
+		return fmt.Errorf("%w: nonce %s, expected %s", ErrInvalidRequest, request.Nonce, expected)
+	}
+
+	domain, err := r.chain.PermitDomain(request.Contract)
+	if err != nil {
+		return fmt.Errorf("failed to get permit domain: %w", err)
+	}
+
+	hash, err := request.Hash(*domain, r.chain.Address(), expected)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	// The contract rejects high S signatures, fail before spending gas on them
+	if err := request.Signature.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+
+	valid, err := evm.VerifyPermitSignature(request.Owner, request.Signature, hash)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+	}
+	if !valid {
+		return fmt.Errorf("%w: signature is not from %s", ErrInvalidRequest, request.Owner.Hex())
+	}
+
+	return nil
+}
+
+func (r *Relayer) relay(request *Request) (common.Hash, error) {
+	if request.Signature.Deadline.Cmp(big.NewInt(r.now().Unix())) < 0 {
+		return common.Hash{}, fmt.Errorf("permit expired in the queue")
+	}
+
+	txHash, err := r.chain.Relay(request)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	r.update(request.ID(), func(status *Status) {
+		status.State = StateSubmitted
+		status.TxHash = txHash.Hex()
+	})
+	return txHash, nil
+}
+
+// finish records the outcome of a request and releases its nonce
+func (r *Relayer) finish(request *Request, err error) {
+	r.update(request.ID(), func(status *Status) {
+		if err != nil {
+			status.State = StateFailed
+			status.Error = err.Error()
+		} else {
+			status.State = StateConfirmed
+		}
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := pendingKey{request.Contract, request.Owner}
+	if r.pending[key]--; r.pending[key] <= 0 {
+		delete(r.pending, key)
+	}
+}
+
+func (r *Relayer) update(id string, apply func(status *Status)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status, found := r.statuses[id]
+	if !found {
+		return
+	}
+
+	apply(status)
+	status.UpdatedAt = r.now()
+}
+
+func (r *Relayer) allowed(contractAddress common.Address) bool {
+	if len(r.config.Contracts) == 0 {
+		return true
+	}
+
+	for _, allowed := range r.config.Contracts {
+		if allowed == contractAddress {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimit counts a submission of owner within a sliding window
+func (r *Relayer) rateLimit(owner common.Address, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	since := now.Add(-r.config.RateWindow)
+	recent := r.submitted[owner][:0]
+	for _, at := range r.submitted[owner] {
+		if at.After(since) {
+			recent = append(recent, at)
+		}
+	}
+
+	if len(recent) >= r.config.RateLimit {
+		r.submitted[owner] = recent
+		return fmt.Errorf("%w: %d permits per %s", ErrRateLimited, r.config.RateLimit, r.config.RateWindow)
+	}
+
+	r.submitted[owner] = append(recent, now)
+	return nil
+}
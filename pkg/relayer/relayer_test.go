@@ -0,0 +1,413 @@
+package relayer_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/relayer"
+)
+
+var (
+	testContract  = common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	testRelayer   = common.HexToAddress("0x8a28fb81A084Ac7A276800957a19a6054BF86E4D")
+	testRecipient = common.HexToAddress("0xde609F435E82D1D5f71105CED56d06dDADB148B3")
+)
+
+// fakeChain holds token owners and permit nonces, and records relayed permits
+type fakeChain struct {
+	mu       sync.Mutex
+	owners   map[string]common.Address
+	nonces   map[common.Address]int64
+	relayed  []*relayer.Request
+	relayErr error
+	waitErr  error
+}
+
+func newFakeChain() *fakeChain {
+	return &fakeChain{
+		owners: make(map[string]common.Address),
+		nonces: make(map[common.Address]int64),
+	}
+}
+
+func (f *fakeChain) Address() common.Address {
+	return testRelayer
+}
+
+func (f *fakeChain) PermitDomain(contractAddress common.Address) (*evm.EIP712Domain, error) {
+	return &evm.EIP712Domain{Name: "MyNFTCert", Version: "1", ChainID: big.NewInt(150), VerifyingContract: contractAddress}, nil
+}
+
+func (f *fakeChain) PermitNonce(_ common.Address, owner common.Address) (*big.Int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return big.NewInt(f.nonces[owner]), nil
+}
+
+func (f *fakeChain) OwnerOf(_ common.Address, tokenID *big.Int) (common.Address, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	owner, found := f.owners[tokenID.String()]
+	if !found {
+		return common.Address{}, errors.New("ERC721NonexistentToken")
+	}
+	return owner, nil
+}
+
+func (f *fakeChain) Relay(request *relayer.Request) (common.Hash, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.relayErr != nil {
+		return common.Hash{}, f.relayErr
+	}
+	f.relayed = append(f.relayed, request)
+	f.nonces[request.Owner]++
+	return common.BigToHash(big.NewInt(int64(len(f.relayed)))), nil
+}
+
+func (f *fakeChain) Wait(common.Hash) error {
+	return f.waitErr
+}
+
+type signer struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func newSigner(t *testing.T) signer {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return signer{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// sign signs request as the EVMClient permit functions do
+func (s signer) sign(t *testing.T, request *relayer.Request, nonce int64, deadline time.Time) *relayer.Request {
+	domain, err := newFakeChain().PermitDomain(request.Contract)
+	require.NoError(t, err)
+
+	unsigned(request, deadline)
+	hash, err := request.Hash(*domain, testRelayer, big.NewInt(nonce))
+	require.NoError(t, err)
+
+	signature, err := evm.SignPermitMessage(s.key, hash)
+	require.NoError(t, err)
+	signature.Deadline = request.Signature.Deadline
+	request.Signature = signature
+	return request
+}
+
+// unsigned gives request a signature that only carries the deadline, for requests rejected before the signature is checked
+func unsigned(request *relayer.Request, deadline time.Time) *relayer.Request {
+	request.Signature = &evm.PermitSignature{Deadline: big.NewInt(deadline.Unix())}
+	return request
+}
+
+func transferRequest(owner common.Address, tokenID int64) *relayer.Request {
+	return &relayer.Request{
+		Kind:     relayer.KindTransfer,
+		Contract: testContract,
+		Owner:    owner,
+		To:       testRecipient,
+		TokenID:  big.NewInt(tokenID),
+	}
+}
+
+func newTestRelayer(t *testing.T, chain *fakeChain, config relayer.Config) *relayer.Relayer {
+	r, err := relayer.New(chain, config)
+	require.NoError(t, err)
+	return r
+}
+
+func TestSubmit(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+
+	t.Run("Queues valid permits with consecutive nonces", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		chain.owners["1"] = owner.address
+		chain.owners["2"] = owner.address
+		r := newTestRelayer(t, chain, relayer.DefaultConfig())
+
+		status, err := r.Submit(owner.sign(t, transferRequest(owner.address, 1), 0, deadline))
+		require.NoError(t, err)
+		assert.Equal(t, relayer.StateQueued, status.State)
+
+		// The first permit is still queued, so the second one must be signed with the next nonce
+		_, err = r.Submit(owner.sign(t, transferRequest(owner.address, 2), 0, deadline))
+		assert.ErrorIs(t, err, relayer.ErrInvalidRequest)
+
+		second := owner.sign(t, transferRequest(owner.address, 2), 1, deadline)
+		second.Nonce = big.NewInt(1)
+		_, err = r.Submit(second)
+		require.NoError(t, err)
+
+		again, err := r.Submit(second)
+		require.NoError(t, err, "A known permit returns its status")
+		assert.Equal(t, relayer.StateQueued, again.State)
+	})
+
+	t.Run("Rejects permits that would revert", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		other := newSigner(t)
+		chain.owners["1"] = owner.address
+
+		config := relayer.DefaultConfig()
+		config.Contracts = []common.Address{testContract}
+		r := newTestRelayer(t, chain, config)
+
+		permitForAll := &relayer.Request{Kind: relayer.KindPermitForAll, Contract: testContract, Owner: owner.address, Operator: testRecipient, Approved: true}
+		otherContract := transferRequest(owner.address, 1)
+		otherContract.Contract = testRecipient
+		wrongNonce := owner.sign(t, transferRequest(owner.address, 1), 5, deadline)
+		wrongNonce.Nonce = big.NewInt(5)
+
+		tests := []struct {
+			name    string
+			request *relayer.Request
+		}{
+			{"Unknown kind", unsigned(&relayer.Request{Kind: "approve", Contract: testContract, Owner: owner.address}, deadline)},
+			{"Missing recipient", unsigned(&relayer.Request{Kind: relayer.KindTransfer, Contract: testContract, Owner: owner.address, TokenID: big.NewInt(1)}, deadline)},
+			{"Missing signature", transferRequest(owner.address, 1)},
+			{"Signed by someone else", other.sign(t, transferRequest(owner.address, 1), 0, deadline)},
+			{"Not the token owner", other.sign(t, transferRequest(other.address, 1), 0, deadline)},
+			{"Expired", owner.sign(t, transferRequest(owner.address, 1), 0, time.Now().Add(-time.Minute))},
+			{"Expires in the queue", owner.sign(t, transferRequest(owner.address, 1), 0, time.Now().Add(time.Second))},
+			{"Wrong nonce", wrongNonce},
+			{"Contract not relayed", owner.sign(t, otherContract, 0, deadline)},
+			{"Permit for all signed by someone else", other.sign(t, permitForAll, 0, deadline)},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := r.Submit(tt.request)
+				assert.ErrorIs(t, err, relayer.ErrInvalidRequest)
+			})
+		}
+	})
+
+	t.Run("Accepts wallet envelopes", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		chain.owners["1"] = owner.address
+		r := newTestRelayer(t, chain, relayer.DefaultConfig())
+
+		domain, err := chain.PermitDomain(testContract)
+		require.NoError(t, err)
+
+		envelope := func(spender common.Address) *evm.PermitEnvelope {
+			envelope, err := evm.NewPermitEnvelope(*domain, evm.Permit{
+				Owner:    owner.address,
+				Spender:  spender,
+				TokenID:  big.NewInt(1),
+				Nonce:    big.NewInt(0),
+				Deadline: big.NewInt(deadline.Unix()),
+			})
+			require.NoError(t, err)
+			require.NoError(t, envelope.Sign(evm.NewKeySigner(owner.key)))
+			return envelope
+		}
+
+		_, err = r.Submit(&relayer.Request{Kind: relayer.KindBurn, Envelope: envelope(testRecipient)})
+		assert.ErrorContains(t, err, "is not the relayer")
+
+		_, err = r.Submit(&relayer.Request{Kind: relayer.KindPermitForAll, Envelope: envelope(testRelayer)})
+		assert.ErrorIs(t, err, relayer.ErrInvalidRequest)
+
+		status, err := r.Submit(&relayer.Request{Kind: relayer.KindBurn, Envelope: envelope(testRelayer)})
+		require.NoError(t, err)
+		assert.Equal(t, relayer.KindBurn, status.Kind)
+		assert.Equal(t, owner.address, status.Owner)
+	})
+
+	t.Run("Rate limits each owner", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		config := relayer.DefaultConfig()
+		config.RateLimit = 2
+		r := newTestRelayer(t, chain, config)
+
+		for nonce := int64(0); nonce < 2; nonce++ {
+			request := &relayer.Request{Kind: relayer.KindPermitForAll, Contract: testContract, Owner: owner.address, Operator: testRecipient, Approved: nonce == 0}
+			_, err := r.Submit(owner.sign(t, request, nonce, deadline))
+			require.NoError(t, err)
+		}
+
+		request := &relayer.Request{Kind: relayer.KindPermitForAll, Contract: testContract, Owner: owner.address, Operator: testRelayer}
+		_, err := r.Submit(owner.sign(t, request, 2, deadline))
+		assert.ErrorIs(t, err, relayer.ErrRateLimited)
+	})
+
+	t.Run("Queue full", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		chain.owners["1"] = owner.address
+		chain.owners["2"] = owner.address
+		config := relayer.DefaultConfig()
+		config.QueueSize = 1
+		r := newTestRelayer(t, chain, config)
+
+		_, err := r.Submit(owner.sign(t, transferRequest(owner.address, 1), 0, deadline))
+		require.NoError(t, err)
+
+		_, err = r.Submit(owner.sign(t, transferRequest(owner.address, 2), 1, deadline))
+		assert.ErrorIs(t, err, relayer.ErrQueueFull)
+	})
+}
+
+func TestRun(t *testing.T) {
+	deadline := time.Now().Add(time.Hour)
+
+	t.Run("Broadcasts in order and records the outcome", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		chain.owners["1"] = owner.address
+		chain.owners["2"] = owner.address
+		r := newTestRelayer(t, chain, relayer.DefaultConfig())
+
+		first, err := r.Submit(owner.sign(t, transferRequest(owner.address, 1), 0, deadline))
+		require.NoError(t, err)
+		second, err := r.Submit(owner.sign(t, transferRequest(owner.address, 2), 1, deadline))
+		require.NoError(t, err)
+
+		runRelayer(t, r, func() bool {
+			status, _ := r.Status(second.ID)
+			return status.State == relayer.StateConfirmed
+		})
+
+		status, found := r.Status(first.ID)
+		require.True(t, found)
+		assert.Equal(t, relayer.StateConfirmed, status.State)
+		assert.NotEmpty(t, status.TxHash)
+
+		require.Len(t, chain.relayed, 2)
+		assert.Equal(t, big.NewInt(1), chain.relayed[0].TokenID)
+
+		// Both nonces were used on-chain, the next permit starts from the chain again
+		chain.owners["3"] = owner.address
+		_, err = r.Submit(owner.sign(t, transferRequest(owner.address, 3), 2, deadline))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed broadcast", func(t *testing.T) {
+		chain := newFakeChain()
+		owner := newSigner(t)
+		chain.owners["1"] = owner.address
+		chain.relayErr = errors.New("insufficient funds for gas")
+		r := newTestRelayer(t, chain, relayer.DefaultConfig())
+
+		submitted, err := r.Submit(owner.sign(t, transferRequest(owner.address, 1), 0, deadline))
+		require.NoError(t, err)
+
+		runRelayer(t, r, func() bool {
+			status, _ := r.Status(submitted.ID)
+			return status.State == relayer.StateFailed
+		})
+
+		status, _ := r.Status(submitted.ID)
+		assert.Contains(t, status.Error, "insufficient funds")
+	})
+}
+
+// runRelayer runs r until done reports true
+func runRelayer(t *testing.T, r *relayer.Relayer, done func() bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	finished := make(chan error, 1)
+	go func() {
+		finished <- r.Run(ctx)
+	}()
+
+	require.Eventually(t, done, time.Second, 5*time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-finished, context.Canceled)
+}
+
+func TestHandler(t *testing.T) {
+	chain := newFakeChain()
+	owner := newSigner(t)
+	chain.owners["1"] = owner.address
+	config := relayer.DefaultConfig()
+	config.RateLimit = 1
+	r := newTestRelayer(t, chain, config)
+
+	server := httptest.NewServer(r.Handler())
+	defer server.Close()
+
+	post := func(body interface{}) *http.Response {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		resp, err := http.Post(server.URL+"/permits", "application/json", bytes.NewReader(data))
+		require.NoError(t, err)
+		return resp
+	}
+
+	request := owner.sign(t, transferRequest(owner.address, 1), 0, time.Now().Add(time.Hour))
+
+	resp := post(request)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	var submitted relayer.Status
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&submitted))
+	assert.Equal(t, request.ID(), submitted.ID)
+
+	t.Run("Poll status", func(t *testing.T) {
+		resp, err := http.Get(fmt.Sprintf("%s/permits/%s", server.URL, submitted.ID))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var status relayer.Status
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+		assert.Equal(t, relayer.StateQueued, status.State)
+	})
+
+	t.Run("Unknown request", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/permits/0x01")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		permitForAll := &relayer.Request{Kind: relayer.KindPermitForAll, Contract: testContract, Owner: owner.address, Operator: testRecipient}
+		owner.sign(t, permitForAll, 1, time.Now().Add(time.Hour))
+
+		tests := []struct {
+			name string
+			body interface{}
+			code int
+		}{
+			{"Invalid", map[string]string{"kind": "transfer"}, http.StatusBadRequest},
+			{"Unknown field", map[string]string{"token": "1"}, http.StatusBadRequest},
+			{"Rate limited", permitForAll, http.StatusTooManyRequests},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp := post(tt.body)
+				defer resp.Body.Close()
+				assert.Equal(t, tt.code, resp.StatusCode)
+			})
+		}
+	})
+}
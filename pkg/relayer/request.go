@@ -0,0 +1,160 @@
+package relayer
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/thesixnetwork/lbb-sdk-go/pkg/evm"
+)
+
+// Kind is the contract function a permit is relayed to
+type Kind string
+
+const (
+	KindTransfer     Kind = "transfer"
+	KindBurn         Kind = "burn"
+	KindPermitForAll Kind = "permitForAll"
+)
+
+// Request is a signed permit for the relayer to broadcast
+// Transfer and burn permits are signed with the relayer's address as spender, since the contract approves msg.sender
+type Request struct {
+	Kind     Kind           `json:"kind"`
+	Contract common.Address `json:"contract"`
+	Owner    common.Address `json:"owner"`
+	// To receives the token of a transfer
+	To      common.Address `json:"to,omitempty"`
+	TokenID *big.Int       `json:"token_id,omitempty"`
+	// Operator and Approved are the approval of a permitForAll
+	Operator common.Address `json:"operator,omitempty"`
+	Approved bool           `json:"approved,omitempty"`
+	// Nonce is the permit nonce the owner signed, the next unused nonce of the owner when empty
+	Nonce     *big.Int             `json:"nonce,omitempty"`
+	Signature *evm.PermitSignature `json:"signature,omitempty"`
+	// Envelope replaces the permit fields and signature for wallets signing with eth_signTypedData_v4
+	// Kind tells a transfer from a burn, To is still needed for a transfer
+	Envelope *evm.PermitEnvelope `json:"envelope,omitempty"`
+}
+
+// resolve fills the permit fields and signature of the request from its envelope
+func (r *Request) resolve(spender common.Address) error {
+	if r.Envelope == nil {
+		return nil
+	}
+
+	if r.Signature != nil {
+		return fmt.Errorf("signature and envelope cannot both be set")
+	}
+
+	domain, err := r.Envelope.Domain()
+	if err != nil {
+		return err
+	}
+	r.Contract = domain.VerifyingContract
+
+	if r.Envelope.IsPermitForAll() {
+		if r.Kind == "" {
+			r.Kind = KindPermitForAll
+		}
+		if r.Kind != KindPermitForAll {
+			return fmt.Errorf("a PermitForAll envelope cannot be relayed as %s", r.Kind)
+		}
+
+		permit, err := r.Envelope.PermitForAll()
+		if err != nil {
+			return err
+		}
+		r.Owner, r.Operator, r.Approved, r.Nonce = permit.Owner, permit.Operator, permit.Approved, permit.Nonce
+	} else {
+		if r.Kind == KindPermitForAll {
+			return fmt.Errorf("a Permit envelope cannot be relayed as %s", r.Kind)
+		}
+
+		permit, err := r.Envelope.Permit()
+		if err != nil {
+			return err
+		}
+		if permit.Spender != spender {
+			return fmt.Errorf("permit spender %s is not the relayer %s", permit.Spender.Hex(), spender.Hex())
+		}
+		r.Owner, r.TokenID, r.Nonce = permit.Owner, permit.TokenID, permit.Nonce
+	}
+
+	r.Signature, err = r.Envelope.PermitSignature()
+	return err
+}
+
+func (r *Request) Validate() error {
+	if r.Contract == (common.Address{}) {
+		return fmt.Errorf("contract cannot be the zero address")
+	}
+
+	if r.Owner == (common.Address{}) {
+		return fmt.Errorf("owner cannot be the zero address")
+	}
+
+	if r.Signature == nil || r.Signature.Deadline == nil {
+		return fmt.Errorf("signature with a deadline is required")
+	}
+
+	switch r.Kind {
+	case KindTransfer:
+		if r.To == (common.Address{}) {
+			return fmt.Errorf("transfer recipient cannot be the zero address")
+		}
+		if r.TokenID == nil {
+			return fmt.Errorf("transfer token ID is required")
+		}
+	case KindBurn:
+		if r.TokenID == nil {
+			return fmt.Errorf("burn token ID is required")
+		}
+	case KindPermitForAll:
+		if r.Operator == (common.Address{}) {
+			return fmt.Errorf("operator cannot be the zero address")
+		}
+	default:
+		return fmt.Errorf("unknown permit kind %q", r.Kind)
+	}
+
+	return nil
+}
+
+// ID identifies the request by its signature, the same permit submitted twice gets the same ID
+func (r *Request) ID() string {
+	return crypto.Keccak256Hash(r.Contract.Bytes(), r.Signature.R[:], r.Signature.S[:], []byte{r.Signature.V}).Hex()
+}
+
+// Hash returns the EIP-712 digest the owner signed for a permit spent by spender with nonce
+func (r *Request) Hash(domain evm.EIP712Domain, spender common.Address, nonce *big.Int) ([]byte, error) {
+	if r.Kind == KindPermitForAll {
+		return domain.PermitForAllHash(r.Owner, r.Operator, r.Approved, nonce, r.Signature.Deadline)
+	}
+	return domain.PermitHash(r.Owner, spender, r.TokenID, nonce, r.Signature.Deadline)
+}
+
+// State is where a request is in the relayer
+type State string
+
+const (
+	StateQueued    State = "queued"
+	StateSubmitted State = "submitted"
+	StateConfirmed State = "confirmed"
+	StateFailed    State = "failed"
+)
+
+// Status is what a client polls for a request
+type Status struct {
+	ID        string         `json:"id"`
+	Kind      Kind           `json:"kind"`
+	Owner     common.Address `json:"owner"`
+	State     State          `json:"state"`
+	TxHash    string         `json:"tx_hash,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}